@@ -0,0 +1,160 @@
+// Command dfcloud-mock serves a fake Dragonfly Cloud API on localhost, for
+// developing Terraform modules without provisioning real resources.
+//
+// Point the provider at it with:
+//
+//	provider "dfcloud" {
+//	  api_key  = "mock"
+//	  api_host = "http://localhost:8080"
+//	}
+//
+// Resources are validated with the same rules as the API, take -delay to
+// provision and delete, and are saved to the -state file so they survive
+// restarts.
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk/fake"
+)
+
+func main() {
+	var (
+		addr      string
+		statePath string
+		delay     time.Duration
+		apiKey    string
+	)
+
+	flag.StringVar(&addr, "addr", "localhost:8080", "address to listen on")
+	flag.StringVar(&statePath, "state", "dfcloud-mock.json", "file to persist resources to, or empty to keep them in memory")
+	flag.DurationVar(&delay, "delay", 10*time.Second, "how long resources take to provision and delete")
+	flag.StringVar(&apiKey, "api-key", "", "API key clients must use, or empty to accept any key")
+	flag.Parse()
+
+	api := fake.NewServer(fake.WithDelay(delay), fake.WithAPIKey(apiKey))
+
+	var handler http.Handler = api
+	if statePath != "" {
+		if err := load(api, statePath); err != nil {
+			log.Fatal(err.Error())
+		}
+		handler = persist(api, statePath)
+	}
+
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           logRequests(handler),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("serving mock Dragonfly Cloud API on http://%s", addr)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err.Error())
+	}
+}
+
+// load restores the resources saved at path, if it exists.
+func load(api *fake.Server, path string) error {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := api.Load(f); err != nil {
+		return err
+	}
+	log.Printf("loaded resources from %s", path)
+	return nil
+}
+
+// persist saves the resources of api to path after each request that
+// changes them.
+func persist(api *fake.Server, path string) http.Handler {
+	var (
+		mu   sync.Mutex
+		last []byte
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		api.ServeHTTP(w, r)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		var buf bytes.Buffer
+		if err := api.Save(&buf); err != nil {
+			log.Printf("failed to save resources: %s", err)
+			return
+		}
+		if bytes.Equal(buf.Bytes(), last) {
+			return
+		}
+		if err := writeFile(path, buf.Bytes()); err != nil {
+			log.Printf("failed to save resources: %s", err)
+			return
+		}
+		last = buf.Bytes()
+	})
+}
+
+// writeFile replaces the file at path with data, so it is never left
+// partially written.
+func writeFile(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// statusRecorder records the status of a response for logging.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		log.Printf("%s %s %d", r.Method, r.URL.RequestURI(), rec.status)
+	})
+}
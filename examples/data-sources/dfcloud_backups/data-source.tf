@@ -0,0 +1,25 @@
+# Latest restorable backup taken before a point in time
+data "dfcloud_backups" "before_incident" {
+  datastore_id   = dfcloud_datastore.cache.id
+  status         = "active"
+  created_before = 1767225600
+  most_recent    = true
+}
+
+# Clone the datastore from the backup
+resource "dfcloud_datastore" "clone" {
+  name = "frontend-cache-clone"
+
+  location = {
+    region   = "us-central1"
+    provider = "gcp"
+  }
+
+  tier = {
+    max_memory_bytes = 12500000000
+    performance_tier = "standard"
+    replicas         = 1
+  }
+
+  restore_from_backup_id = data.dfcloud_backups.before_incident.backups[0].id
+}
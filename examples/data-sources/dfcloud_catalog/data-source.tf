@@ -0,0 +1,26 @@
+data "dfcloud_catalog" "aws" {
+  cloud_provider = "aws"
+}
+
+locals {
+  enhanced = one([
+    for t in data.dfcloud_catalog.aws.providers[0].performance_tiers : t
+    if t.performance_tier == "enhanced"
+  ])
+}
+
+# The smallest datastore of the enhanced tier
+resource "dfcloud_datastore" "small" {
+  name = "small"
+
+  location = {
+    provider = "aws"
+    region   = "us-east-1"
+  }
+
+  tier = {
+    performance_tier = "enhanced"
+    max_memory_bytes = local.enhanced.max_memory_bytes[0]
+    replicas         = 1
+  }
+}
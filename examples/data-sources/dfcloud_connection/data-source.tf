@@ -0,0 +1,9 @@
+data "dfcloud_connection" "payments" {
+  name = "payments-vpc"
+}
+
+# Accept the peering request in the peer AWS account
+resource "aws_vpc_peering_connection_accepter" "payments" {
+  vpc_peering_connection_id = data.dfcloud_connection.payments.peer_connection_id
+  auto_accept               = true
+}
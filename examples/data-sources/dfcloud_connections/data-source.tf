@@ -0,0 +1,10 @@
+# Connections waiting for the peering request to be accepted
+data "dfcloud_connections" "pending_approval" {
+  status = "inactive"
+}
+
+output "pending_approval" {
+  value = {
+    for c in data.dfcloud_connections.pending_approval.connections : c.name => c.peer.vpc_id
+  }
+}
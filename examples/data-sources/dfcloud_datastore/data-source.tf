@@ -0,0 +1,13 @@
+# Look up a datastore managed in another Terraform configuration
+data "dfcloud_datastore" "cache" {
+  name = "frontend-cache"
+}
+
+output "cache_addr" {
+  value = data.dfcloud_datastore.cache.addr
+}
+
+output "cache_password" {
+  value     = data.dfcloud_datastore.cache.password
+  sensitive = true
+}
@@ -0,0 +1,12 @@
+# All active production datastores in a region
+data "dfcloud_datastores" "prod" {
+  name_regex     = "^prod-"
+  cloud_provider = "aws"
+  region         = "us-east-1"
+  status         = "active"
+}
+
+# Scrape every datastore from the monitoring stack
+output "scrape_targets" {
+  value = [for ds in data.dfcloud_datastores.prod.datastores : ds.addr]
+}
@@ -0,0 +1,20 @@
+# Network managed by the platform team
+data "dfcloud_network" "shared" {
+  name = "shared-us-east-1"
+}
+
+resource "dfcloud_datastore" "cache" {
+  name       = "orders-cache"
+  network_id = data.dfcloud_network.shared.id
+
+  location = {
+    provider = data.dfcloud_network.shared.location.provider
+    region   = data.dfcloud_network.shared.location.region
+  }
+
+  tier = {
+    max_memory_bytes = 12500000000
+    performance_tier = "standard"
+    replicas         = 1
+  }
+}
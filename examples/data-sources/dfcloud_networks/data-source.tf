@@ -0,0 +1,12 @@
+# All active networks in AWS
+data "dfcloud_networks" "aws" {
+  cloud_provider = "aws"
+  status         = "active"
+}
+
+# VPCs to accept peering connections from
+output "vpcs" {
+  value = {
+    for n in data.dfcloud_networks.aws.networks : n.name => n.vpc.resource_id
+  }
+}
@@ -0,0 +1 @@
+terraform import dfcloud_backup.before_migration backup-id
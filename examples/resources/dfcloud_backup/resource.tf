@@ -0,0 +1,19 @@
+resource "dfcloud_datastore" "cache" {
+  name = "frontend-cache"
+
+  location = {
+    region   = "us-central1"
+    provider = "gcp"
+  }
+
+  tier = {
+    max_memory_bytes = 12500000000
+    performance_tier = "standard"
+    replicas         = 1
+  }
+}
+
+# Take a backup before a risky change
+resource "dfcloud_backup" "before_migration" {
+  datastore_id = dfcloud_datastore.cache.id
+}
@@ -76,3 +76,32 @@ resource "dfcloud_datastore" "private" {
     cache_mode = false
   }
 }
+
+# Datastore with daily backups
+resource "dfcloud_datastore" "backed_up" {
+  name = "sessions"
+
+  location = {
+    region   = "us-central1"
+    provider = "gcp"
+  }
+
+  tier = {
+    max_memory_bytes = 12500000000
+    performance_tier = "standard"
+    replicas         = 1
+  }
+
+  backup_policy = {
+    enabled   = true
+    retention = 7
+    every_day = true
+    hours     = [2, 14]
+  }
+
+  # Allow longer for larger datastores to provision and resize
+  timeouts {
+    create = "1h"
+    update = "1h"
+  }
+}
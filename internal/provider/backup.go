@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dragonflydb/terraform-provider-dfcloud/internal/resource_model"
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// backupFieldAttributes maps API field paths to the backup schema attributes
+// where they differ. The backup fields all match their attributes.
+var backupFieldAttributes = map[string]string{}
+
+// Default backup timeouts, used unless overridden in the timeouts block.
+const (
+	defaultBackupCreateTimeout = 30 * time.Minute
+	defaultBackupReadTimeout   = 5 * time.Minute
+	defaultBackupDeleteTimeout = 5 * time.Minute
+)
+
+// backupTimeouts are the operations of the backup timeouts block. Backups
+// can't be updated, so there is no update timeout.
+var backupTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Delete: true,
+}
+
+type BackupResource struct {
+	client *dfcloud.Client
+}
+
+func NewBackupResource() resource.Resource {
+	return &BackupResource{}
+}
+
+func (r *BackupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "backup"
+}
+
+func (r *BackupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an on-demand backup of a Dragonfly datastore.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the backup.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"datastore_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the datastore to back up.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "How the backup was taken, either `manual` or `scheduled`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the backup.",
+				Computed:            true,
+			},
+			"size_bytes": schema.Int64Attribute{
+				MarkdownDescription: "The size of the backup in bytes.",
+				Computed:            true,
+			},
+			"created_at": schema.Int64Attribute{
+				MarkdownDescription: "The timestamp when the backup was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.Int64Attribute{
+				MarkdownDescription: "The timestamp when the backup will be deleted, based on the datastore backup retention. 0 if the backup doesn't expire.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, backupTimeouts),
+		},
+	}
+}
+
+func (r *BackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dfcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *dfcloud.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resource_model.BackupResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backupConfig := &dfcloud.BackupConfig{
+		DatastoreID: plan.DatastoreID.ValueString(),
+	}
+	respBackup, err := r.client.CreateBackup(ctx, backupConfig)
+	if err != nil {
+		addAPIErrorForPlan(ctx, &resp.Diagnostics, "failed to create backup", err, req.Plan, backupFieldAttributes)
+		return
+	}
+
+	// Save before waiting, see addCreateWaitError.
+	plan.Backup = *resource_model.FromBackup(respBackup)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultBackupCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	waitForBackupStatusCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	respBackup, err = r.client.WaitForBackup(waitForBackupStatusCtx, respBackup.ID, dfcloud.BackupStatusActive)
+	if err != nil {
+		addCreateWaitError(&resp.Diagnostics, "failed to wait for backup", "backup", err)
+		return
+	}
+
+	plan.Backup = *resource_model.FromBackup(respBackup)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *BackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resource_model.BackupResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.ID.IsNull() || state.ID.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultBackupReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	respBackup, err := r.client.GetBackup(ctx, state.ID.ValueString())
+	if errors.Is(err, dfcloud.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "failed to read backup", err)
+		return
+	}
+	// Expired backups are deleted by the API, so they are recreated on the
+	// next apply.
+	if respBackup.Status == dfcloud.BackupStatusDeleted {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Backup = *resource_model.FromBackup(respBackup)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only saves the timeouts, since changing anything else replaces the
+// backup.
+func (r *BackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan resource_model.BackupResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *resource_model.BackupResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state == nil || state.ID.IsNull() || state.ID.ValueString() == "" {
+		return
+	}
+
+	err := r.client.DeleteBackup(ctx, state.ID.ValueString())
+	if errors.Is(err, dfcloud.ErrNotFound) {
+		tflog.Warn(ctx, "backup is already deleted", map[string]any{
+			"backup_id": state.ID.ValueString(),
+		})
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "failed to delete backup", err)
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultBackupDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// wait until backup is deleted
+	waitForBackupStatusCtx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	_, err = r.client.WaitForBackup(waitForBackupStatusCtx, state.ID.ValueString(), dfcloud.BackupStatusDeleted)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "failed to wait for backup deletion", err)
+		return
+	}
+}
+
+func (r *BackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	backup, err := r.client.GetBackup(ctx, req.ID)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "failed to get backup", err)
+		return
+	}
+
+	state := resource_model.BackupResource{
+		Backup:   *resource_model.FromBackup(backup),
+		Timeouts: nullTimeouts(backupTimeouts),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+var (
+	_ resource.Resource                = &BackupResource{}
+	_ resource.ResourceWithImportState = &BackupResource{}
+)
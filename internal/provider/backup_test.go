@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk/fake"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUnit_BackupResource_lifecycle(t *testing.T) {
+	// Each request moves the clock forward by 40 minutes, so the datastore
+	// and backup are still pending on the first request of each wait.
+	clock := newTestUnitClock()
+	clock.setStep(40 * time.Minute)
+	p := newTestUnitProvider(t, fake.WithDelay(time.Hour), fake.WithClock(clock.Now))
+
+	datastore, err := p.apply("dfcloud_datastore", tftypes.Value{}, testUnitDatastoreConfig("tf-test"))
+	if err != nil {
+		t.Fatalf("create datastore error = %v", err)
+	}
+	datastoreID := testUnitAttr(t, datastore, "id")
+
+	state, err := p.apply("dfcloud_backup", tftypes.Value{}, map[string]any{
+		"datastore_id": datastoreID,
+	})
+	if err != nil {
+		t.Fatalf("create error = %v", err)
+	}
+	id := testUnitAttr(t, state, "id")
+	testUnitCheckAttrs(t, state, map[string]string{
+		"datastore_id": datastoreID,
+		"type":         "manual",
+		"status":       "active",
+		"size_bytes":   "300000000",
+	})
+	if b, _ := p.api.Backup(id); b.Status != dfcloud.BackupStatusActive {
+		t.Errorf("API backup status = %q, want %q", b.Status, dfcloud.BackupStatusActive)
+	}
+
+	// Changing only the timeouts updates the state without replacing the
+	// backup.
+	state, err = p.apply("dfcloud_backup", state, map[string]any{
+		"datastore_id": datastoreID,
+		"timeouts":     map[string]any{"create": "45m", "delete": "10m"},
+	})
+	if err != nil {
+		t.Fatalf("update timeouts error = %v", err)
+	}
+	testUnitCheckAttrs(t, state, map[string]string{
+		"id":              id,
+		"status":          "active",
+		"size_bytes":      "300000000",
+		"timeouts.create": "45m",
+		"timeouts.delete": "10m",
+	})
+
+	imported, err := p.importState("dfcloud_backup", id)
+	if err != nil {
+		t.Fatalf("import error = %v", err)
+	}
+	testUnitCheckAttrs(t, imported, map[string]string{
+		"id":           id,
+		"datastore_id": datastoreID,
+		"type":         "manual",
+		"status":       "active",
+	})
+
+	if err := p.destroy("dfcloud_backup", state); err != nil {
+		t.Fatalf("destroy error = %v", err)
+	}
+	if _, ok := p.api.Backup(id); ok {
+		t.Fatalf("backup %s still exists", id)
+	}
+
+	state, err = p.refresh("dfcloud_backup", state)
+	if err != nil {
+		t.Fatalf("refresh error = %v", err)
+	}
+	if !state.IsNull() {
+		t.Fatalf("refresh state = %v, want the deleted backup removed", state)
+	}
+}
+
+func TestUnit_BackupResource_datastoreNotFound(t *testing.T) {
+	p := newTestUnitProvider(t)
+
+	state, err := p.apply("dfcloud_backup", tftypes.Value{}, map[string]any{
+		"datastore_id": "dst_missing",
+	})
+	if err == nil || !strings.Contains(err.Error(), "datastore not found") {
+		t.Fatalf("create error = %v, want the datastore not to be found", err)
+	}
+	if !state.IsNull() {
+		t.Fatalf("create state = %v, want no backup", state)
+	}
+}
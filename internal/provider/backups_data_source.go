@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/dragonflydb/terraform-provider-dfcloud/internal/resource_model"
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+type BackupsDataSource struct {
+	client *dfcloud.Client
+}
+
+func NewBackupsDataSource() datasource.DataSource {
+	return &BackupsDataSource{}
+}
+
+func (d *BackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "dfcloud_backups"
+}
+
+func (d *BackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the backups of Dragonfly datastores, newest first.",
+		Attributes: map[string]schema.Attribute{
+			"datastore_id": schema.StringAttribute{
+				MarkdownDescription: "Only include backups of the given datastore.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only include backups of the given type, either `manual` or `scheduled`.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only include backups with the given status. Use `active` to only include backups that can be restored.",
+				Optional:            true,
+			},
+			"created_after": schema.Int64Attribute{
+				MarkdownDescription: "Only include backups created at or after the given timestamp.",
+				Optional:            true,
+			},
+			"created_before": schema.Int64Attribute{
+				MarkdownDescription: "Only include backups created at or before the given timestamp.",
+				Optional:            true,
+			},
+			"most_recent": schema.BoolAttribute{
+				MarkdownDescription: "Only include the most recent matching backup. Unless `status` is set, only `active` backups are considered, so the backup can be restored.",
+				Optional:            true,
+			},
+			"backups": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching backups, newest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the backup.",
+							Computed:            true,
+						},
+						"datastore_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the backed up datastore.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "How the backup was taken, either `manual` or `scheduled`.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the backup.",
+							Computed:            true,
+						},
+						"size_bytes": schema.Int64Attribute{
+							MarkdownDescription: "The size of the backup in bytes.",
+							Computed:            true,
+						},
+						"created_at": schema.Int64Attribute{
+							MarkdownDescription: "The timestamp when the backup was created.",
+							Computed:            true,
+						},
+						"expires_at": schema.Int64Attribute{
+							MarkdownDescription: "The timestamp when the backup will be deleted. 0 if the backup doesn't expire.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dfcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError("failed to get provider", "failed to get provider")
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state resource_model.Backups
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := resource_model.IntoListBackupsOptions(state)
+	// The most recent backup is usually looked up to restore it, which only
+	// works for active backups.
+	if state.MostRecent.ValueBool() && state.Status.IsNull() {
+		opts.Status = dfcloud.BackupStatusActive
+	}
+	backups, err := d.client.ListBackups(ctx, opts)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "failed to list backups", err)
+		return
+	}
+
+	// Newest first, so the first backup is the one to restore.
+	slices.SortStableFunc(backups, func(a, b *dfcloud.Backup) int {
+		return cmp.Or(cmp.Compare(b.CreatedAt, a.CreatedAt), cmp.Compare(a.ID, b.ID))
+	})
+	if state.MostRecent.ValueBool() && len(backups) > 1 {
+		backups = backups[:1]
+	}
+
+	state.Backups = make([]*resource_model.Backup, 0, len(backups))
+	for _, backup := range backups {
+		state.Backups = append(state.Backups, resource_model.FromBackup(backup))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+var _ datasource.DataSourceWithConfigure = &BackupsDataSource{}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk/fake"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUnit_BackupsDataSource_read(t *testing.T) {
+	// Move the clock on each request so the backups are created at
+	// different times.
+	clock := newTestUnitClock()
+	clock.setStep(time.Minute)
+	p := newTestUnitProvider(t, fake.WithClock(clock.Now))
+
+	var datastoreIDs []string
+	for _, name := range []string{"tf-test-1", "tf-test-2"} {
+		state, err := p.apply("dfcloud_datastore", tftypes.Value{}, testUnitDatastoreConfig(name))
+		if err != nil {
+			t.Fatalf("create datastore %s error = %v", name, err)
+		}
+		datastoreIDs = append(datastoreIDs, testUnitAttr(t, state, "id"))
+	}
+	var backupIDs []string
+	for _, datastoreID := range []string{datastoreIDs[0], datastoreIDs[0], datastoreIDs[1]} {
+		state, err := p.apply("dfcloud_backup", tftypes.Value{}, map[string]any{
+			"datastore_id": datastoreID,
+		})
+		if err != nil {
+			t.Fatalf("create backup error = %v", err)
+		}
+		backupIDs = append(backupIDs, testUnitAttr(t, state, "id"))
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		want   []string
+	}{
+		{
+			name:   "all",
+			config: map[string]any{},
+			want:   []string{backupIDs[2], backupIDs[1], backupIDs[0]},
+		},
+		{
+			name:   "datastore",
+			config: map[string]any{"datastore_id": datastoreIDs[0]},
+			want:   []string{backupIDs[1], backupIDs[0]},
+		},
+		{
+			name:   "most recent",
+			config: map[string]any{"datastore_id": datastoreIDs[0], "most_recent": true},
+			want:   []string{backupIDs[1]},
+		},
+		{
+			name:   "type",
+			config: map[string]any{"type": "scheduled"},
+			want:   nil,
+		},
+		{
+			name:   "status",
+			config: map[string]any{"status": "active", "datastore_id": datastoreIDs[1]},
+			want:   []string{backupIDs[2]},
+		},
+	}
+	for _, tt := range tests {
+		state, err := p.readDataSource("dfcloud_backups", tt.config)
+		if err != nil {
+			t.Fatalf("%s: read error = %v", tt.name, err)
+		}
+		checks := map[string]string{"backups.#": strconv.Itoa(len(tt.want))}
+		for i, id := range tt.want {
+			checks[fmt.Sprintf("backups.%d.id", i)] = id
+			checks[fmt.Sprintf("backups.%d.status", i)] = "active"
+		}
+		testUnitCheckAttrs(t, state, checks)
+	}
+}
+
+func TestUnit_BackupsDataSource_mostRecentActive(t *testing.T) {
+	clock := newTestUnitClock()
+	clock.setStep(40 * time.Minute)
+	p := newTestUnitProvider(t, fake.WithDelay(time.Hour), fake.WithClock(clock.Now))
+
+	datastore, err := p.apply("dfcloud_datastore", tftypes.Value{}, testUnitDatastoreConfig("tf-test"))
+	if err != nil {
+		t.Fatalf("create datastore error = %v", err)
+	}
+	datastoreID := testUnitAttr(t, datastore, "id")
+	state, err := p.apply("dfcloud_backup", tftypes.Value{}, map[string]any{
+		"datastore_id": datastoreID,
+	})
+	if err != nil {
+		t.Fatalf("create backup error = %v", err)
+	}
+	activeID := testUnitAttr(t, state, "id")
+
+	// Take a newer backup outside Terraform, and stop the clock so it stays
+	// pending.
+	clock.setStep(0)
+	pending, err := p.client.CreateBackup(context.Background(), &dfcloud.BackupConfig{DatastoreID: datastoreID})
+	if err != nil {
+		t.Fatalf("CreateBackup() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		want   string
+		status string
+	}{
+		{
+			name:   "active by default",
+			config: map[string]any{"most_recent": true},
+			want:   activeID,
+			status: "active",
+		},
+		{
+			name:   "status",
+			config: map[string]any{"most_recent": true, "status": "pending"},
+			want:   pending.ID,
+			status: "pending",
+		},
+	}
+	for _, tt := range tests {
+		state, err := p.readDataSource("dfcloud_backups", tt.config)
+		if err != nil {
+			t.Fatalf("%s: read error = %v", tt.name, err)
+		}
+		testUnitCheckAttrs(t, state, map[string]string{
+			"backups.#":        "1",
+			"backups.0.id":     tt.want,
+			"backups.0.status": tt.status,
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/dragonflydb/terraform-provider-dfcloud/internal/resource_model"
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type CatalogDataSource struct {
+	client *dfcloud.Client
+}
+
+func NewCatalogDataSource() datasource.DataSource {
+	return &CatalogDataSource{}
+}
+
+func (d *CatalogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "dfcloud_catalog"
+}
+
+func (d *CatalogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the cloud providers, regions and performance tiers datastores can be provisioned in, with the permitted memory sizes of each tier.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_provider": schema.StringAttribute{
+				MarkdownDescription: "Only include the given cloud provider, such as `aws`.",
+				Optional:            true,
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Where the catalog came from. `api` if it was fetched from the API, or `embedded` if the API doesn't serve a catalog and the catalog built into the provider was used.",
+				Computed:            true,
+			},
+			"providers": schema.ListNestedAttribute{
+				MarkdownDescription: "The supported cloud providers.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"provider": schema.StringAttribute{
+							MarkdownDescription: "The cloud provider, used as the datastore `location.provider`.",
+							Computed:            true,
+						},
+						"regions": schema.ListNestedAttribute{
+							MarkdownDescription: "The supported regions of the provider.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"region": schema.StringAttribute{
+										MarkdownDescription: "The region, used as the datastore `location.region`.",
+										Computed:            true,
+									},
+									"availability_zones": schema.ListAttribute{
+										MarkdownDescription: "The availability zones of the region, used in the datastore `location.availability_zones`.",
+										ElementType:         types.StringType,
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"performance_tiers": schema.ListNestedAttribute{
+							MarkdownDescription: "The supported performance tiers of the provider.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"performance_tier": schema.StringAttribute{
+										MarkdownDescription: "The performance tier, used as the datastore `tier.performance_tier`.",
+										Computed:            true,
+									},
+									"max_memory_bytes": schema.ListAttribute{
+										MarkdownDescription: "The permitted `tier.max_memory_bytes` of non-cluster datastores, smallest first.",
+										ElementType:         types.Int64Type,
+										Computed:            true,
+									},
+									"shard_memory_bytes": schema.ListAttribute{
+										MarkdownDescription: "The permitted `cluster.shard_memory` of cluster datastores, smallest first. The `tier.max_memory_bytes` of cluster datastores must be a multiple of the shard memory. Empty if the shard sizes of the tier aren't listed.",
+										ElementType:         types.Int64Type,
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CatalogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dfcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError("failed to get provider", "failed to get provider")
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CatalogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state resource_model.Catalog
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalog, fromAPI, err := d.client.GetCatalogOrDefault(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "failed to get catalog", err)
+		return
+	}
+	state.Source = types.StringValue("api")
+	if !fromAPI {
+		tflog.Info(ctx, "the API doesn't serve a catalog, using the embedded catalog")
+		state.Source = types.StringValue("embedded")
+	}
+
+	state.Providers = []*resource_model.CatalogProvider{}
+	for _, p := range catalog.Providers {
+		if !state.CloudProvider.IsNull() && string(p.Provider) != state.CloudProvider.ValueString() {
+			continue
+		}
+		state.Providers = append(state.Providers, resource_model.FromCatalogProvider(p))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+var _ datasource.DataSourceWithConfigure = &CatalogDataSource{}
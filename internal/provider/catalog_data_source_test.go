@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk/fake"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnit_CatalogDataSource(t *testing.T) {
+	_, providerConfig := testUnitFakeAPI(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "dfcloud_catalog" "all" {}
+
+data "dfcloud_catalog" "aws" {
+  cloud_provider = "aws"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dfcloud_catalog.all", "source", "api"),
+					resource.TestCheckResourceAttr("data.dfcloud_catalog.all", "providers.#", "3"),
+					resource.TestCheckResourceAttr("data.dfcloud_catalog.aws", "providers.#", "1"),
+					resource.TestCheckResourceAttr("data.dfcloud_catalog.aws", "providers.0.provider", "aws"),
+					resource.TestCheckResourceAttr("data.dfcloud_catalog.aws", "providers.0.regions.0.region", "us-east-1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.dfcloud_catalog.aws", "providers.0.performance_tiers.*", map[string]string{
+						"performance_tier": "extreme",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.dfcloud_catalog.aws", "providers.0.performance_tiers.*", map[string]string{
+						"performance_tier": "byoc",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestUnit_CatalogDataSource_read(t *testing.T) {
+	catalog := &dfcloud.Catalog{
+		Providers: []dfcloud.CatalogProvider{
+			{
+				Provider: dfcloud.CloudProviderAWS,
+				Regions: []dfcloud.CatalogRegion{
+					{Region: "eu-west-1", AvailabilityZones: []string{"euw1-az1", "euw1-az2"}},
+				},
+				PerformanceTiers: []dfcloud.CatalogTier{
+					{
+						PerformanceTier:  dfcloud.PerformanceTierStandard,
+						MaxMemoryBytes:   []uint64{12500000000, 25000000000},
+						ShardMemoryBytes: []uint64{12500000000},
+					},
+				},
+			},
+			{
+				Provider: dfcloud.CloudProviderGCP,
+				Regions:  []dfcloud.CatalogRegion{{Region: "us-central1"}},
+			},
+		},
+	}
+	p := newTestUnitProvider(t, fake.WithCatalog(catalog))
+
+	state, err := p.readDataSource("dfcloud_catalog", map[string]any{})
+	if err != nil {
+		t.Fatalf("read error = %v", err)
+	}
+	testUnitCheckAttrs(t, state, map[string]string{
+		"source":               "api",
+		"providers.#":          "2",
+		"providers.0.provider": "aws",
+		"providers.1.provider": "gcp",
+	})
+
+	state, err = p.readDataSource("dfcloud_catalog", map[string]any{"cloud_provider": "aws"})
+	if err != nil {
+		t.Fatalf("read aws error = %v", err)
+	}
+	testUnitCheckAttrs(t, state, map[string]string{
+		"source":                       "api",
+		"providers.#":                  "1",
+		"providers.0.provider":         "aws",
+		"providers.0.regions.0.region": "eu-west-1",
+		"providers.0.regions.0.availability_zones.#":           "2",
+		"providers.0.performance_tiers.#":                      "1",
+		"providers.0.performance_tiers.0.performance_tier":     "standard",
+		"providers.0.performance_tiers.0.max_memory_bytes.#":   "2",
+		"providers.0.performance_tiers.0.max_memory_bytes.1":   "25000000000",
+		"providers.0.performance_tiers.0.shard_memory_bytes.0": "12500000000",
+	})
+
+	// The embedded catalog is used if the API doesn't serve one.
+	p.api.InjectFault(fake.Fault{
+		Method:     http.MethodGet,
+		Path:       "/v1/catalog",
+		StatusCode: http.StatusNotFound,
+		Message:    "not found",
+	})
+	state, err = p.readDataSource("dfcloud_catalog", map[string]any{})
+	if err != nil {
+		t.Fatalf("read embedded error = %v", err)
+	}
+	testUnitCheckAttrs(t, state, map[string]string{
+		"source":      "embedded",
+		"providers.#": "3",
+	})
+}
@@ -7,10 +7,10 @@ import (
 
 	"github.com/dragonflydb/terraform-provider-dfcloud/internal/resource_model"
 	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +18,30 @@ import (
 	"github.com/samber/lo"
 )
 
+// connectionFieldAttributes maps API field paths to the connection schema
+// attributes where they differ.
+var connectionFieldAttributes = map[string]string{
+	"peer.azure.resource_group":      "peer.azure_resource_group",
+	"peer.azure.tenant_id":           "peer.azure_tenant_id",
+	"peer.azure.app_object_id":       "peer.azure_app_object_id",
+	"peer.azure.use_remote_gateways": "peer.azure_use_remote_gateways",
+}
+
+// Default connection timeouts, used unless overridden in the timeouts block.
+const (
+	defaultConnectionCreateTimeout = 15 * time.Minute
+	defaultConnectionReadTimeout   = 5 * time.Minute
+	defaultConnectionDeleteTimeout = 15 * time.Minute
+)
+
+// connectionTimeouts are the operations of the connection timeouts block.
+// Connections can't be updated, so there is no update timeout.
+var connectionTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Delete: true,
+}
+
 type ConnectionResource struct {
 	client *dfcloud.Client
 }
@@ -72,26 +96,44 @@ func (r *ConnectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 					"account_id": schema.StringAttribute{
 						MarkdownDescription: "The account ID of the target VPC.",
 						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"vpc_id": schema.StringAttribute{
 						MarkdownDescription: "The ID of the target VPC.",
 						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"region": schema.StringAttribute{
 						MarkdownDescription: "The region of the target VPC. Only required for AWS cross-region connections.",
 						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"azure_resource_group": schema.StringAttribute{
 						MarkdownDescription: "The Azure resource group of the peer VNet. Required for Azure network connections.",
 						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"azure_tenant_id": schema.StringAttribute{
 						MarkdownDescription: "The Azure tenant ID. Required for Azure network connections.",
 						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"azure_app_object_id": schema.StringAttribute{
 						MarkdownDescription: "The object ID of the Azure AD application used for peering. Required for Azure network connections.",
 						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"azure_use_remote_gateways": schema.BoolAttribute{
 						MarkdownDescription: "Whether to use remote gateways in the Azure VNet peering. Defaults to false.",
@@ -99,14 +141,15 @@ func (r *ConnectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 						Computed:            true,
 						PlanModifiers: []planmodifier.Bool{
 							boolplanmodifier.UseStateForUnknown(),
+							boolplanmodifier.RequiresReplace(),
 						},
 					},
 				},
-				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.RequiresReplace(),
-				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, connectionTimeouts),
+		},
 	}
 }
 
@@ -134,30 +177,33 @@ func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequ
 	connConfig := resource_model.IntoConnectionConfig(state)
 	respConn, err := r.client.CreateConnection(ctx, connConfig)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to create connection", err.Error())
+		addAPIErrorForPlan(ctx, &resp.Diagnostics, "failed to create connection", err, req.Plan, connectionFieldAttributes)
+		return
+	}
+
+	// Save before waiting, see addCreateWaitError.
+	setConnectionStatus(&state, respConn)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := state.Timeouts.Create(ctx, defaultConnectionCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// wait until VPC IDs are created
-	waitForConnectionStatusCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	waitForConnectionStatusCtx, cancel := context.WithTimeout(ctx, createTimeout)
 	defer cancel()
-	respConn, err = resource_model.WaitUntilConnectionStatus(waitForConnectionStatusCtx, r.client, respConn.ID, dfcloud.ConnectionStatusInactive)
+	respConn, err = r.client.WaitForConnection(waitForConnectionStatusCtx, respConn.ID, dfcloud.ConnectionStatusInactive)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to wait for connection", err.Error())
+		addCreateWaitError(&resp.Diagnostics, "failed to wait for connection", "connection", err)
 		return
 	}
 
-	state.ConnectionID = types.StringValue(respConn.ID)
-	state.Status = types.StringValue(string(respConn.Status))
-	state.StatusDetail = types.StringValue(respConn.StatusDetail)
-	state.PeerConnID = types.StringValue(respConn.PeerConnectionID)
-	azConfig := lo.FromPtr(respConn.Config).Peer.AzureConfig
-	if azConfig.TenantID != "" {
-		state.Peer.AzureUseRemoteGateways = types.BoolValue(azConfig.UseRemoteGateways)
-	} else {
-		state.Peer.AzureUseRemoteGateways = types.BoolNull()
-	}
-
+	setConnectionStatus(&state, respConn)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -171,6 +217,13 @@ func (r *ConnectionResource) Read(ctx context.Context, req resource.ReadRequest,
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultConnectionReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	respConn, err := r.client.GetConnection(ctx, state.ConnectionID.ValueString())
 	if errors.Is(err, dfcloud.ErrNotFound) {
@@ -178,7 +231,7 @@ func (r *ConnectionResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("failed to read connection", err.Error())
+		addAPIError(&resp.Diagnostics, "failed to read connection", err)
 		return
 	}
 
@@ -187,26 +240,37 @@ func (r *ConnectionResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	state.ConnectionID = types.StringValue(respConn.ID)
-	state.Status = types.StringValue(string(respConn.Status))
-	state.StatusDetail = types.StringValue(respConn.StatusDetail)
-	state.PeerConnID = types.StringValue(respConn.PeerConnectionID)
-	azConfig := lo.FromPtr(respConn.Config).Peer.AzureConfig
+	setConnectionStatus(&state, respConn)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// setConnectionStatus updates the computed attributes of state from the
+// connection returned by the API.
+func setConnectionStatus(state *resource_model.Connection, conn *dfcloud.Connection) {
+	state.ConnectionID = types.StringValue(conn.ID)
+	state.Status = types.StringValue(string(conn.Status))
+	state.StatusDetail = types.StringValue(conn.StatusDetail)
+	state.PeerConnID = types.StringValue(conn.PeerConnectionID)
+	azConfig := lo.FromPtr(conn.Config).Peer.AzureConfig
 	if azConfig.TenantID != "" {
 		state.Peer.AzureUseRemoteGateways = types.BoolValue(azConfig.UseRemoteGateways)
 	} else {
 		state.Peer.AzureUseRemoteGateways = types.BoolNull()
 	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// Update only saves the timeouts, since changing anything else replaces the
+// connection.
 func (r *ConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Connections can't be updated
-	resp.Diagnostics.AddError(
-		"Updating a Connection is not supported",
-		"Updating a Connection is not supported",
-	)
+	var state, plan *resource_model.Connection
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *ConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -228,16 +292,22 @@ func (r *ConnectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("failed to delete connection", err.Error())
+		addAPIError(&resp.Diagnostics, "failed to delete connection", err)
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultConnectionDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// wait until connection is deleted
-	waitForConnectionStatusCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	waitForConnectionStatusCtx, cancel := context.WithTimeout(ctx, deleteTimeout)
 	defer cancel()
-	_, err = resource_model.WaitUntilConnectionStatus(waitForConnectionStatusCtx, r.client, state.ConnectionID.ValueString(), dfcloud.ConnectionStatusDeleted)
+	_, err = r.client.WaitForConnection(waitForConnectionStatusCtx, state.ConnectionID.ValueString(), dfcloud.ConnectionStatusDeleted)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to wait for connection", err.Error())
+		addAPIError(&resp.Diagnostics, "failed to wait for connection", err)
 		return
 	}
 }
@@ -245,11 +315,12 @@ func (r *ConnectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 func (r *ConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	connection, err := r.client.GetConnection(ctx, req.ID)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to get network", err.Error())
+		addAPIError(&resp.Diagnostics, "failed to get network", err)
 		return
 	}
 
 	state := resource_model.FromConnectionConfig(connection)
+	state.Timeouts = nullTimeouts(connectionTimeouts)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
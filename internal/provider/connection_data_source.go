@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dragonflydb/terraform-provider-dfcloud/internal/resource_model"
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ConnectionDataSource struct {
+	client *dfcloud.Client
+}
+
+func NewConnectionDataSource() datasource.DataSource {
+	return &ConnectionDataSource{}
+}
+
+func (d *ConnectionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "dfcloud_connection"
+}
+
+func (d *ConnectionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := connectionDataSourceAttributes()
+	attributes["connection_id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the connection. Exactly one of `connection_id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the connection. Exactly one of `connection_id` or `name` must be set. The name must match exactly one connection.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing Dragonfly network connection by ID or name.",
+		Attributes:          attributes,
+	}
+}
+
+// connectionDataSourceAttributes returns the computed attributes of a
+// connection in data sources, matching the dfcloud_connection resource.
+func connectionDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"connection_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the connection.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the connection.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The status of the connection. `inactive` connections are waiting for the peering request to be accepted.",
+			Computed:            true,
+		},
+		"status_detail": schema.StringAttribute{
+			MarkdownDescription: "Additional details about the connection status.",
+			Computed:            true,
+		},
+		"peer_connection_id": schema.StringAttribute{
+			MarkdownDescription: "The underlying cloud provider connection ID.",
+			Computed:            true,
+		},
+		"network_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the connected network.",
+			Computed:            true,
+		},
+		"peer": schema.SingleNestedAttribute{
+			MarkdownDescription: "The connected VPC.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"account_id": schema.StringAttribute{
+					MarkdownDescription: "The account ID of the target VPC.",
+					Computed:            true,
+				},
+				"vpc_id": schema.StringAttribute{
+					MarkdownDescription: "The ID of the target VPC.",
+					Computed:            true,
+				},
+				"region": schema.StringAttribute{
+					MarkdownDescription: "The region of the target VPC, if it differs from the network region.",
+					Computed:            true,
+				},
+				"azure_resource_group": schema.StringAttribute{
+					MarkdownDescription: "The Azure resource group of the peer VNet.",
+					Computed:            true,
+				},
+				"azure_tenant_id": schema.StringAttribute{
+					MarkdownDescription: "The Azure tenant ID.",
+					Computed:            true,
+				},
+				"azure_app_object_id": schema.StringAttribute{
+					MarkdownDescription: "The object ID of the Azure AD application used for peering.",
+					Computed:            true,
+				},
+				"azure_use_remote_gateways": schema.BoolAttribute{
+					MarkdownDescription: "Whether remote gateways are used in the Azure VNet peering.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func (d *ConnectionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dfcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError("failed to get provider", "failed to get provider")
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConnectionDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var id, name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("connection_id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() || id.IsUnknown() || name.IsUnknown() {
+		return
+	}
+
+	if id.IsNull() == name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("connection_id"),
+			"Invalid Connection Lookup",
+			"Exactly one of connection_id or name must be set.",
+		)
+	}
+}
+
+func (d *ConnectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config resource_model.ConnectionDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		conn *dfcloud.Connection
+		err  error
+	)
+	if !config.ConnectionID.IsNull() {
+		conn, err = d.client.GetConnection(ctx, config.ConnectionID.ValueString())
+		if errors.Is(err, dfcloud.ErrNotFound) {
+			resp.Diagnostics.AddAttributeError(path.Root("connection_id"), "connection not found", fmt.Sprintf("No connection has ID %q.", config.ConnectionID.ValueString()))
+			return
+		}
+	} else {
+		conn, err = d.findConnectionByName(ctx, config.Name.ValueString())
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "failed to read connection", err)
+		return
+	}
+	if conn == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "connection not found", fmt.Sprintf("No connection is named %q.", config.Name.ValueString()))
+		return
+	}
+	if conn.Config == nil {
+		resp.Diagnostics.AddError("failed to read connection", fmt.Sprintf("The API returned connection %s without its configuration.", conn.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, resource_model.FromConnectionDataSourceConfig(conn))...)
+}
+
+// findConnectionByName returns the connection with the given name, or nil if
+// there is none. Connections being deleted are ignored, so the name of a
+// deleted connection can be reused.
+func (d *ConnectionDataSource) findConnectionByName(ctx context.Context, name string) (*dfcloud.Connection, error) {
+	conns, err := d.client.ListConnections(ctx, &dfcloud.ListConnectionsOptions{NamePrefix: name})
+	if err != nil {
+		return nil, err
+	}
+
+	var found *dfcloud.Connection
+	for _, conn := range conns {
+		if conn.Config == nil || conn.Config.Name != name || conn.Status == dfcloud.ConnectionStatusDeleting || conn.Status == dfcloud.ConnectionStatusDeleted {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("multiple connections are named %q (%s and %s), look up the connection by connection_id instead", name, found.ID, conn.ID)
+		}
+		found = conn
+	}
+	return found, nil
+}
+
+var (
+	_ datasource.DataSourceWithConfigure      = &ConnectionDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &ConnectionDataSource{}
+)
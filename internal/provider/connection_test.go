@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk/fake"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testUnitCheckConnectionDestroy(api *fake.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "dfcloud_connection" {
+				continue
+			}
+			if _, ok := api.Connection(rs.Primary.ID); ok {
+				return fmt.Errorf("connection %s still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func TestUnit_ConnectionResource(t *testing.T) {
+	api, providerConfig := testUnitFakeAPI(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testUnitCheckConnectionDestroy(api),
+			testUnitCheckNetworkDestroy(api),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testUnitConnectionResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dfcloud_connection.test", "name", "tf-test"),
+					resource.TestCheckResourceAttr("dfcloud_connection.test", "status", "inactive"),
+					resource.TestCheckResourceAttrPair("dfcloud_connection.test", "network_id", "dfcloud_network.test", "id"),
+					resource.TestCheckResourceAttrSet("dfcloud_connection.test", "connection_id"),
+					resource.TestCheckResourceAttrSet("dfcloud_connection.test", "peer_connection_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnit_ConnectionDataSources(t *testing.T) {
+	_, providerConfig := testUnitFakeAPI(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testUnitConnectionResourceConfig() + `
+data "dfcloud_connection" "test" {
+  connection_id = dfcloud_connection.test.connection_id
+}
+
+data "dfcloud_connections" "inactive" {
+  network_id = dfcloud_network.test.id
+  status     = "inactive"
+
+  depends_on = [dfcloud_connection.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dfcloud_connection.test", "name", "tf-test"),
+					resource.TestCheckResourceAttr("data.dfcloud_connection.test", "status", "inactive"),
+					resource.TestCheckResourceAttr("data.dfcloud_connection.test", "peer.vpc_id", "vpc-0123456789abcdef0"),
+					resource.TestCheckResourceAttrPair("data.dfcloud_connection.test", "peer_connection_id", "dfcloud_connection.test", "peer_connection_id"),
+					resource.TestCheckResourceAttr("data.dfcloud_connections.inactive", "connections.#", "1"),
+					resource.TestCheckResourceAttrPair("data.dfcloud_connections.inactive", "ids.0", "dfcloud_connection.test", "connection_id"),
+				),
+			},
+		},
+	})
+}
+
+func testUnitConnectionResourceConfig() string {
+	return testAccNetworkResourceConfig("tf-test") + `
+resource "dfcloud_connection" "test" {
+  name       = "tf-test"
+  network_id = dfcloud_network.test.id
+
+  peer = {
+    account_id = "123456789012"
+    vpc_id     = "vpc-0123456789abcdef0"
+  }
+}
+`
+}
+
+func testUnitConnectionConfig(networkID string) map[string]any {
+	return map[string]any{
+		"name":       "tf-test",
+		"network_id": networkID,
+		"peer": map[string]any{
+			"account_id": "123456789012",
+			"vpc_id":     "vpc-0123456789abcdef0",
+		},
+	}
+}
+
+func TestUnit_ConnectionResource_timeouts(t *testing.T) {
+	clock := newTestUnitClock()
+	clock.setStep(40 * time.Minute)
+	p := newTestUnitProvider(t, fake.WithDelay(time.Hour), fake.WithClock(clock.Now))
+
+	network, err := p.apply("dfcloud_network", tftypes.Value{}, testUnitNetworkConfig("tf-test"))
+	if err != nil {
+		t.Fatalf("create network error = %v", err)
+	}
+
+	blocks := p.resourceSchema("dfcloud_connection").Block.BlockTypes
+	for _, block := range blocks {
+		if block.TypeName != "timeouts" {
+			continue
+		}
+		for _, attr := range block.Block.Attributes {
+			if attr.Name == "update" {
+				t.Errorf("timeouts has an update timeout, but connections can't be updated")
+			}
+		}
+	}
+
+	// Stop the clock so the connection never finishes transitioning, and
+	// only the configured timeouts end the waits.
+	clock.setStep(0)
+	config := testUnitConnectionConfig(testUnitAttr(t, network, "id"))
+	config["timeouts"] = map[string]any{
+		"create": "1ms",
+		"read":   "1ms",
+		"delete": "1ms",
+	}
+	state, err := p.apply("dfcloud_connection", tftypes.Value{}, config)
+	if err == nil || !strings.Contains(err.Error(), "terraform untaint") {
+		t.Fatalf("create error = %v, want a timeout explaining the connection is tainted", err)
+	}
+	id := testUnitAttr(t, state, "connection_id")
+	testUnitCheckAttrs(t, state, map[string]string{
+		"status": "pending",
+	})
+
+	// Retrying the failed read outlasts the read timeout.
+	p.api.InjectFault(fake.Fault{
+		Method:     http.MethodGet,
+		Path:       "/v1/connections/" + id,
+		StatusCode: http.StatusServiceUnavailable,
+		Message:    "unavailable",
+	})
+	if _, err := p.refresh("dfcloud_connection", state); err == nil || !strings.Contains(err.Error(), "deadline exceeded") {
+		t.Fatalf("refresh error = %v, want the read to time out", err)
+	}
+
+	imported, err := p.importState("dfcloud_connection", id)
+	if err != nil {
+		t.Fatalf("import error = %v", err)
+	}
+	testUnitCheckAttrs(t, imported, map[string]string{
+		"connection_id": id,
+		"status":        "pending",
+	})
+
+	err = p.destroy("dfcloud_connection", state)
+	if err == nil || !strings.Contains(err.Error(), "deadline exceeded") {
+		t.Fatalf("destroy error = %v, want the wait to time out", err)
+	}
+	if conn, _ := p.api.Connection(id); conn.Status != dfcloud.ConnectionStatusDeleting {
+		t.Errorf("API connection status = %q, want %q", conn.Status, dfcloud.ConnectionStatusDeleting)
+	}
+}
+
+func TestUnit_ConnectionResource_updateTimeouts(t *testing.T) {
+	p := newTestUnitProvider(t)
+
+	network, err := p.apply("dfcloud_network", tftypes.Value{}, testUnitNetworkConfig("tf-test"))
+	if err != nil {
+		t.Fatalf("create network error = %v", err)
+	}
+	config := testUnitConnectionConfig(testUnitAttr(t, network, "id"))
+	config["timeouts"] = map[string]any{"create": "30m"}
+	state, err := p.apply("dfcloud_connection", tftypes.Value{}, config)
+	if err != nil {
+		t.Fatalf("create error = %v", err)
+	}
+	id := testUnitAttr(t, state, "connection_id")
+
+	// Changing only the timeouts updates the state without replacing the
+	// connection.
+	config["timeouts"] = map[string]any{"create": "45m", "delete": "10m"}
+	state, err = p.apply("dfcloud_connection", state, config)
+	if err != nil {
+		t.Fatalf("update timeouts error = %v", err)
+	}
+	testUnitCheckAttrs(t, state, map[string]string{
+		"connection_id":   id,
+		"status":          "inactive",
+		"timeouts.create": "45m",
+		"timeouts.delete": "10m",
+	})
+}
+
+func TestUnit_ConnectionDataSources_read(t *testing.T) {
+	p := newTestUnitProvider(t)
+	networkIDs := testUnitCreateNetworks(t, p, map[string][2]string{
+		"tf-test": {"aws", "us-east-1"},
+		"other":   {"aws", "us-east-1"},
+	})
+
+	ids := map[string]string{}
+	for i, conn := range []struct{ name, network string }{
+		{"tf-test-b", "tf-test"},
+		{"tf-test-a", "tf-test"},
+		{"tf-test-c", "other"},
+		{"other", "tf-test"},
+	} {
+		config := testUnitConnectionConfig(networkIDs[conn.network])
+		config["name"] = conn.name
+		config["peer"].(map[string]any)["vpc_id"] = fmt.Sprintf("vpc-%d", i)
+		state, err := p.apply("dfcloud_connection", tftypes.Value{}, config)
+		if err != nil {
+			t.Fatalf("create connection %s error = %v", conn.name, err)
+		}
+		ids[conn.name] = testUnitAttr(t, state, "connection_id")
+	}
+	p.api.FailConnection(ids["tf-test-b"], dfcloud.ConnectionStatusFailed, "peer rejected the connection")
+
+	for _, config := range []map[string]any{
+		{"connection_id": ids["tf-test-a"]},
+		{"name": "tf-test-a"},
+	} {
+		state, err := p.readDataSource("dfcloud_connection", config)
+		if err != nil {
+			t.Fatalf("read %v error = %v", config, err)
+		}
+		testUnitCheckAttrs(t, state, map[string]string{
+			"connection_id":      ids["tf-test-a"],
+			"name":               "tf-test-a",
+			"network_id":         networkIDs["tf-test"],
+			"status":             "inactive",
+			"peer_connection_id": "pcx-" + ids["tf-test-a"],
+			"peer.account_id":    "123456789012",
+			"peer.vpc_id":        "vpc-1",
+		})
+	}
+	state, err := p.readDataSource("dfcloud_connection", map[string]any{"connection_id": ids["tf-test-b"]})
+	if err != nil {
+		t.Fatalf("read failed connection error = %v", err)
+	}
+	testUnitCheckAttrs(t, state, map[string]string{
+		"status":        "failed",
+		"status_detail": "peer rejected the connection",
+	})
+	for config, wantErr := range map[string]string{
+		"connection_id": `No connection has ID "conn_missing"`,
+		"name":          `No connection is named "conn_missing"`,
+	} {
+		_, err := p.readDataSource("dfcloud_connection", map[string]any{config: "conn_missing"})
+		if err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("read by missing %s error = %v, want %q", config, err, wantErr)
+		}
+	}
+
+	tests := []struct {
+		config map[string]any
+		want   []string
+	}{
+		{
+			config: map[string]any{},
+			want:   []string{"other", "tf-test-a", "tf-test-b", "tf-test-c"},
+		},
+		{
+			config: map[string]any{"name_regex": "^tf-test-"},
+			want:   []string{"tf-test-a", "tf-test-b", "tf-test-c"},
+		},
+		{
+			config: map[string]any{"name_regex": "^tf-test-", "network_id": networkIDs["tf-test"]},
+			want:   []string{"tf-test-a", "tf-test-b"},
+		},
+		{
+			config: map[string]any{"status": "inactive"},
+			want:   []string{"other", "tf-test-a", "tf-test-c"},
+		},
+		{
+			config: map[string]any{"status": "failed"},
+			want:   []string{"tf-test-b"},
+		},
+	}
+	for _, tt := range tests {
+		state, err := p.readDataSource("dfcloud_connections", tt.config)
+		if err != nil {
+			t.Fatalf("read %v error = %v", tt.config, err)
+		}
+		checks := map[string]string{
+			"ids.#":         strconv.Itoa(len(tt.want)),
+			"connections.#": strconv.Itoa(len(tt.want)),
+		}
+		for i, name := range tt.want {
+			checks[fmt.Sprintf("ids.%d", i)] = ids[name]
+			checks[fmt.Sprintf("connections.%d.name", i)] = name
+		}
+		testUnitCheckAttrs(t, state, checks)
+	}
+
+	if _, err := p.readDataSource("dfcloud_connections", map[string]any{"name_regex": "("}); err == nil || !strings.Contains(err.Error(), "Invalid Regular Expression") {
+		t.Errorf("read with an invalid regex error = %v, want Invalid Regular Expression", err)
+	}
+}
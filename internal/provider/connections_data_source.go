@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/dragonflydb/terraform-provider-dfcloud/internal/resource_model"
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ConnectionsDataSource struct {
+	client *dfcloud.Client
+}
+
+func NewConnectionsDataSource() datasource.DataSource {
+	return &ConnectionsDataSource{}
+}
+
+func (d *ConnectionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "dfcloud_connections"
+}
+
+func (d *ConnectionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Dragonfly network connections, ordered by name and then ID.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only include connections whose name matches the [regular expression](https://github.com/google/re2/wiki/Syntax).",
+				Optional:            true,
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Only include connections to the given network.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only include connections with the given status. Use `inactive` to find connections waiting for the peering request to be accepted.",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matching connections, in the same order as `connections`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"connections": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching connections, ordered by name and then ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: connectionDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ConnectionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dfcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError("failed to get provider", "failed to get provider")
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConnectionsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var nameRegex types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+	if resp.Diagnostics.HasError() || nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Regular Expression",
+			fmt.Sprintf("name_regex is not a valid regular expression: %s.", err),
+		)
+	}
+}
+
+func (d *ConnectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state resource_model.Connections
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+	}
+
+	conns, err := d.client.ListConnections(ctx, resource_model.IntoListConnectionsOptions(state))
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "failed to list connections", err)
+		return
+	}
+
+	conns = slices.DeleteFunc(conns, func(conn *dfcloud.Connection) bool {
+		return conn.Config == nil || (nameRegex != nil && !nameRegex.MatchString(conn.Config.Name))
+	})
+	// Sort so adding a connection doesn't reorder the others in the plan.
+	slices.SortStableFunc(conns, func(a, b *dfcloud.Connection) int {
+		return cmp.Or(cmp.Compare(a.Config.Name, b.Config.Name), cmp.Compare(a.ID, b.ID))
+	})
+
+	state.IDs = make([]types.String, 0, len(conns))
+	state.Connections = make([]*resource_model.ConnectionDataSource, 0, len(conns))
+	for _, conn := range conns {
+		model := resource_model.FromConnectionDataSourceConfig(conn)
+		state.IDs = append(state.IDs, model.ConnectionID)
+		state.Connections = append(state.Connections, model)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+var (
+	_ datasource.DataSourceWithConfigure      = &ConnectionsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &ConnectionsDataSource{}
+)
@@ -8,15 +8,22 @@ import (
 
 	"github.com/dragonflydb/terraform-provider-dfcloud/internal/resource_model"
 	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -25,6 +32,24 @@ func NewDatastoreResource() resource.Resource {
 	return &datastoreResource{}
 }
 
+// datastoreFieldAttributes maps API field paths to the datastore schema
+// attributes where they differ.
+var datastoreFieldAttributes = map[string]string{
+	"disable_passkey":                "disable_pass_key",
+	"byoc.account_id":                "byoc_account_id",
+	"tier.byoc_instance_family":      "tier.byoc_instance_family_name",
+	"tier.byoc_instance_family.name": "tier.byoc_instance_family_name",
+}
+
+// Default datastore timeouts, used unless overridden in the timeouts block.
+// Large datastores can take a while to provision or resize.
+const (
+	defaultDatastoreCreateTimeout = 30 * time.Minute
+	defaultDatastoreReadTimeout   = 5 * time.Minute
+	defaultDatastoreUpdateTimeout = 30 * time.Minute
+	defaultDatastoreDeleteTimeout = 15 * time.Minute
+)
+
 // datastoreResource is the resource implementation.
 type datastoreResource struct {
 	client *dfcloud.Client
@@ -36,9 +61,9 @@ func (r *datastoreResource) Metadata(_ context.Context, req resource.MetadataReq
 }
 
 // Schema defines the schema for the resource.
-func (r *datastoreResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *datastoreResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Dragonfly datastore resource.",
+		MarkdownDescription: "Manages a Dragonfly datastore resource.\n\nIf a new datastore doesn't become active within the create timeout, it is saved to state but marked as tainted, so the next apply replaces it. Run `terraform untaint` to keep it instead, and the next plan waits up to the read timeout for it to become active.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the datastore.",
@@ -72,6 +97,21 @@ func (r *datastoreResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the datastore.",
+				Computed:            true,
+			},
+			"status_detail": schema.StringAttribute{
+				MarkdownDescription: "Additional details about the datastore status.",
+				Computed:            true,
+			},
+			"dashboard_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the datastore Grafana dashboard.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"addr": schema.StringAttribute{
 				MarkdownDescription: "The address of the datastore.",
 				Computed:            true,
@@ -91,7 +131,7 @@ func (r *datastoreResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 				Attributes: map[string]schema.Attribute{
 					"shard_memory": schema.Int64Attribute{
-						MarkdownDescription: "The cluster shard memory in bytes. For example, `6250000000` represents 6.25 GB. If not set, the shard memory is managed automatically.\n\n  Permitted values: `6250000000` (6.25 GB), `12500000000` (12.5 GB), `25000000000` (25 GB), `50000000000` (50 GB), `100000000000` (100 GB).",
+						MarkdownDescription: "The cluster shard memory in bytes. For example, `6250000000` represents 6.25 GB. If not set, the shard memory is managed automatically.\n\n  The permitted values depend on the cloud provider and performance tier, and are listed by the `shard_memory_bytes` of the `dfcloud_catalog` data source.",
 						Optional:            true,
 					},
 				},
@@ -127,7 +167,7 @@ func (r *datastoreResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:            true,
 				Attributes: map[string]schema.Attribute{
 					"max_memory_bytes": schema.Int64Attribute{
-						MarkdownDescription: "The maximum memory (in bytes) for the datastore. For example, `12500000000` represents 12.5 GB.\n\n  The permitted values listed below apply to non-swarm datastores. For swarm datastores, the value must be a multiple of `shard_memory` (e.g. `shard_memory` × number of shards).\n\n  Permitted values by cloud provider and performance tier (non-swarm):\n\n  | Provider | Tier     | Permitted values (bytes)                                                                                       |\n  |----------|----------|----------------------------------------------------------------------------------------------------------------|\n  | AWS      | dev      | `3e9`                                                                                                          |\n  | AWS      | byoc     | `6.25e9`, `12.5e9`, `25e9`, `50e9`, `100e9`, `200e9`, `400e9`                                                 |\n  | AWS      | standard | `12.5e9`, `25e9`, `50e9`, `100e9`, `200e9`, `400e9`                                                           |\n  | AWS      | enhanced | `6.25e9`, `12.5e9`, `25e9`, `50e9`, `100e9`, `200e9`, `300e9`, `400e9`                                        |\n  | AWS      | extreme  | `6.25e9`, `12.5e9`, `25e9`, `50e9`, `100e9`, `200e9`                                                          |\n  | GCP      | dev      | `3e9`                                                                                                          |\n  | GCP      | byoc     | `6.25e9`, `12.5e9`, `25e9`, `50e9`, `100e9`, `200e9`, `300e9`, `400e9`                                        |\n  | GCP      | standard | `12.5e9`, `25e9`, `50e9`, `100e9`, `200e9`, `300e9`, `400e9`                                                  |\n  | GCP      | enhanced | `6.25e9`, `12.5e9`, `25e9`, `50e9`, `100e9`, `150e9`, `200e9`, `250e9`, `300e9`, `400e9`                      |\n  | GCP      | extreme  | `6.25e9`, `12.5e9`, `25e9`, `50e9`, `100e9`, `150e9`, `200e9`                                                 |\n  | Azure    | dev      | `3e9`                                                                                                          |\n  | Azure    | standard | `12.5e9`, `25e9`, `50e9`, `100e9`, `200e9`, `300e9`, `400e9`                                                  |\n  | Azure    | enhanced | `6.5e9`, `12.5e9`, `25e9`, `50e9`, `100e9`, `150e9`, `200e9`, `300e9`                                         |\n  | Azure    | extreme  | `6.5e9`, `12.5e9`, `25e9`, `50e9`, `100e9`                                                                    |",
+						MarkdownDescription: "The maximum memory (in bytes) for the datastore. For example, `12500000000` represents 12.5 GB.\n\n  The permitted values depend on the cloud provider and performance tier, and are listed by the `max_memory_bytes` of the `dfcloud_catalog` data source. For swarm datastores, the value must instead be a multiple of `shard_memory` (e.g. `shard_memory` × number of shards).",
 						Required:            true,
 					},
 					"performance_tier": schema.StringAttribute{
@@ -237,6 +277,61 @@ func (r *datastoreResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					},
 				},
 			},
+			"restore_from_backup_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of a backup to restore. When set on create the datastore is provisioned from the backup. Changing it restores the backup into the existing datastore, replacing its data. Removing it has no effect.",
+				Optional:            true,
+			},
+			"backup_policy": schema.SingleNestedAttribute{
+				MarkdownDescription: "The backup policy for the datastore. Only one schedule can be used: `every_hour`, `every_day` at the given `hours`, or the given `weekdays` at the given `hours`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Enable scheduled backups.",
+						Required:            true,
+					},
+					"retention": schema.Int64Attribute{
+						MarkdownDescription: "The number of days to keep backups for.",
+						Optional:            true,
+						Computed:            true,
+					},
+					// The schedule attributes default to false or empty rather
+					// than keeping their previous value, so switching schedules
+					// clears the previous one.
+					"every_hour": schema.BoolAttribute{
+						MarkdownDescription: "Take a backup every hour. Cannot be combined with `every_day`, `hours` or `weekdays`. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"every_day": schema.BoolAttribute{
+						MarkdownDescription: "Take a backup every day at the given `hours`. Cannot be combined with `every_hour` or `weekdays`. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"hours": schema.ListAttribute{
+						MarkdownDescription: "The hours of the day to take backups at. 0-23. Defaults to none.",
+						ElementType:         types.Int64Type,
+						Optional:            true,
+						Computed:            true,
+						Default:             listdefault.StaticValue(types.ListValueMust(types.Int64Type, []attr.Value{})),
+					},
+					"weekdays": schema.ListAttribute{
+						MarkdownDescription: "The days of the week to take backups on, at the given `hours`. 0-6, 0 is Sunday. Defaults to none.",
+						ElementType:         types.Int64Type,
+						Optional:            true,
+						Computed:            true,
+						Default:             listdefault.StaticValue(types.ListValueMust(types.Int64Type, []attr.Value{})),
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
 		},
 	}
 }
@@ -259,6 +354,67 @@ func (r *datastoreResource) Configure(_ context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+// ValidateConfig validates the backup policy schedule.
+func (r *datastoreResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var backupPolicy types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("backup_policy"), &backupPolicy)...)
+	if resp.Diagnostics.HasError() || backupPolicy.IsNull() || backupPolicy.IsUnknown() {
+		return
+	}
+
+	var policy resource_model.DatastoreBackupPolicy
+	resp.Diagnostics.Append(backupPolicy.As(ctx, &policy, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyPath := path.Root("backup_policy")
+	hours := validateInt64List(ctx, &resp.Diagnostics, policyPath.AtName("hours"), policy.Hours, 0, 23)
+	weekDays := validateInt64List(ctx, &resp.Diagnostics, policyPath.AtName("weekdays"), policy.WeekDays, 0, 6)
+
+	if policy.EveryHour.ValueBool() && (policy.EveryDay.ValueBool() || len(hours) > 0 || len(weekDays) > 0) {
+		resp.Diagnostics.AddAttributeError(
+			policyPath.AtName("every_hour"),
+			"Invalid Backup Schedule",
+			"every_hour cannot be combined with every_day, hours or weekdays.",
+		)
+	}
+	if policy.EveryDay.ValueBool() && len(weekDays) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			policyPath.AtName("every_day"),
+			"Invalid Backup Schedule",
+			"every_day cannot be combined with weekdays.",
+		)
+	}
+}
+
+// validateInt64List checks the known elements of list are between minValue
+// and maxValue, returning them.
+func validateInt64List(ctx context.Context, diags *diag.Diagnostics, p path.Path, list types.List, minValue int64, maxValue int64) []int64 {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	var values []types.Int64
+	diags.Append(list.ElementsAs(ctx, &values, false)...)
+
+	var known []int64
+	for i, v := range values {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		if v.ValueInt64() < minValue || v.ValueInt64() > maxValue {
+			diags.AddAttributeError(
+				p.AtListIndex(i),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Value must be between %d and %d, got: %d.", minValue, maxValue, v.ValueInt64()),
+			)
+		}
+		known = append(known, v.ValueInt64())
+	}
+	return known
+}
+
 // Create a new resource.
 func (r *datastoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan resource_model.Datastore
@@ -276,15 +432,31 @@ func (r *datastoreResource) Create(ctx context.Context, req resource.CreateReque
 
 	respDatastore, err := r.client.CreateDatastore(ctx, &datastore.Config)
 	if err != nil {
-		resp.Diagnostics.AddError("Error Creating Datastore", err.Error())
+		addAPIErrorForPlan(ctx, &resp.Diagnostics, "Error Creating Datastore", err, req.Plan, datastoreFieldAttributes)
+		return
+	}
+
+	// Save before waiting, see addCreateWaitError.
+	plan.FromConfig(ctx, respDatastore)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultDatastoreCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
 	defer cancel()
-	respDatastore, err = resource_model.WaitForDatastoreStatus(ctx, r.client, respDatastore.ID, dfcloud.DatastoreStatusActive)
+	if datastore.Config.Restore.BackupId != "" {
+		respDatastore, err = r.client.WaitForDatastoreRestore(ctx, respDatastore.ID)
+	} else {
+		respDatastore, err = r.client.WaitForDatastore(ctx, respDatastore.ID, dfcloud.DatastoreStatusActive)
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error Creating Datastore", err.Error())
+		addCreateWaitError(&resp.Diagnostics, "Error Waiting for Datastore Creation", "datastore", err)
 		return
 	}
 
@@ -311,13 +483,21 @@ func (r *datastoreResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultDatastoreReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	respDatastore, err := r.client.GetDatastore(ctx, state.ID.ValueString())
 	if errors.Is(err, dfcloud.ErrNotFound) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error Reading Datastore", err.Error())
+		addAPIError(&resp.Diagnostics, "Error Reading Datastore", err)
 		return
 	}
 
@@ -325,6 +505,26 @@ func (r *datastoreResource) Read(ctx context.Context, req resource.ReadRequest,
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	// Resume waiting for a datastore whose create timed out. Other changes
+	// in progress, such as updates made outside Terraform, are read as is
+	// so refreshing doesn't block on them.
+	if respDatastore.Status == dfcloud.DatastoreStatusPending {
+		provisioned, err := r.client.WaitForDatastoreProvisioned(ctx, respDatastore.ID)
+		switch {
+		case errors.Is(err, dfcloud.ErrNotFound):
+			resp.State.RemoveResource(ctx)
+			return
+		case errors.Is(err, context.DeadlineExceeded):
+			tflog.Warn(ctx, "datastore is still pending, reading it as is", map[string]any{
+				"datastore_id": respDatastore.ID,
+			})
+		case err != nil:
+			addAPIError(&resp.Diagnostics, "Error Waiting for Datastore", err)
+			return
+		default:
+			respDatastore = provisioned
+		}
+	}
 
 	tflog.Info(ctx, "read datastore", map[string]any{
 		"datastore_id": respDatastore.ID,
@@ -345,37 +545,63 @@ func (r *datastoreResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	// retreive datastore to check if it is active
-	respDatastore, err := r.client.GetDatastore(ctx, state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error Updating Datastore", err.Error())
+	var plan resource_model.Datastore
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if respDatastore.Status == dfcloud.DatastoreStatusUpdating || respDatastore.Status == dfcloud.DatastoreStatusPending || respDatastore.Status == dfcloud.DatastoreStatusDeleting {
-		resp.Diagnostics.AddError("Error Reading Datastore", "Datastore is not active")
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultDatastoreUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
-	var plan resource_model.Datastore
-	diags = req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	// The API rejects updates while the datastore is changing, so wait for
+	// a previous create, update or restore to finish first.
+	respDatastore, err := r.client.GetDatastore(ctx, state.ID.ValueString())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Updating Datastore", err)
+		return
+	}
+	if respDatastore.Status == dfcloud.DatastoreStatusDeleting {
+		resp.Diagnostics.AddError(
+			"Error Updating Datastore",
+			fmt.Sprintf("Datastore %s is being deleted, so can't be updated.", respDatastore.ID),
+		)
 		return
 	}
+	if datastoreInProgress(respDatastore.Status) {
+		if _, err := r.client.WaitForDatastoreRestore(ctx, respDatastore.ID); err != nil {
+			addAPIError(&resp.Diagnostics, "Error Waiting for Datastore Before Update", err)
+			return
+		}
+	}
 
 	updateDatastore := resource_model.IntoDatastoreConfig(plan)
+	// Only request a restore when the backup changes, otherwise every update
+	// would restore the backup again.
+	restore := updateDatastore.Config.Restore.BackupId != "" &&
+		!plan.RestoreFromBackupID.Equal(state.RestoreFromBackupID)
+	if !restore {
+		updateDatastore.Config.Restore = dfcloud.RestoreBackup{}
+	}
 	respDatastore, err = r.client.UpdateDatastore(ctx, state.ID.ValueString(), &updateDatastore.Config)
 	if err != nil {
-		resp.Diagnostics.AddError("Error Updating Datastore", err.Error())
+		addAPIErrorForPlan(ctx, &resp.Diagnostics, "Error Updating Datastore", err, req.Plan, datastoreFieldAttributes)
 		return
 	}
 
-	waitForDatastoreStatusCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
-	defer cancel()
-	respDatastore, err = resource_model.WaitForDatastoreStatus(waitForDatastoreStatusCtx, r.client, respDatastore.ID, dfcloud.DatastoreStatusActive)
+	if restore {
+		respDatastore, err = r.client.WaitForDatastoreRestore(ctx, respDatastore.ID)
+	} else {
+		respDatastore, err = r.client.WaitForDatastore(ctx, respDatastore.ID, dfcloud.DatastoreStatusActive)
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error Waiting for Datastore Update", err.Error())
+		addAPIError(&resp.Diagnostics, "Error Waiting for Datastore Update", err)
 		return
 	}
 
@@ -409,15 +635,20 @@ func (r *datastoreResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error Deleting Datastore", err.Error())
+		addAPIError(&resp.Diagnostics, "Error Deleting Datastore", err)
 		return
 	}
 
-	waitForDatastoreStatusCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDatastoreDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	waitForDatastoreStatusCtx, cancel := context.WithTimeout(ctx, deleteTimeout)
 	defer cancel()
-	_, err = resource_model.WaitForDatastoreStatus(waitForDatastoreStatusCtx, r.client, state.ID.ValueString(), dfcloud.DatastoreStatusDeleted)
+	_, err = r.client.WaitForDatastore(waitForDatastoreStatusCtx, state.ID.ValueString(), dfcloud.DatastoreStatusDeleted)
 	if err != nil {
-		resp.Diagnostics.AddError("Error Deleting Datastore", err.Error())
+		addAPIError(&resp.Diagnostics, "Error Deleting Datastore", err)
 		return
 	}
 
@@ -430,16 +661,28 @@ func (r *datastoreResource) Delete(ctx context.Context, req resource.DeleteReque
 func (r *datastoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	datastore, err := r.client.GetDatastore(ctx, req.ID)
 	if err != nil {
-		resp.Diagnostics.AddError("Error Importing Datastore", err.Error())
+		addAPIError(&resp.Diagnostics, "Error Importing Datastore", err)
 		return
 	}
 
-	var plan resource_model.Datastore
+	plan := resource_model.Datastore{
+		Timeouts: nullTimeouts(allTimeouts),
+	}
 	plan.FromConfig(ctx, datastore)
 	diags := resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 }
 
+// datastoreInProgress reports whether the datastore is being provisioned,
+// updated or restored.
+func datastoreInProgress(status dfcloud.DatastoreStatus) bool {
+	switch status {
+	case dfcloud.DatastoreStatusPending, dfcloud.DatastoreStatusUpdating, dfcloud.DatastoreStatusRestoring:
+		return true
+	}
+	return false
+}
+
 // clusterPlanModifier is a custom plan modifier for the 'cluster' attribute.
 type clusterPlanModifier struct{}
 
@@ -467,7 +710,8 @@ func (m clusterPlanModifier) PlanModifyObject(ctx context.Context, req planmodif
 }
 
 var (
-	_ resource.Resource                = &datastoreResource{}
-	_ resource.ResourceWithConfigure   = &datastoreResource{}
-	_ resource.ResourceWithImportState = &datastoreResource{}
+	_ resource.Resource                   = &datastoreResource{}
+	_ resource.ResourceWithConfigure      = &datastoreResource{}
+	_ resource.ResourceWithImportState    = &datastoreResource{}
+	_ resource.ResourceWithValidateConfig = &datastoreResource{}
 )
@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dragonflydb/terraform-provider-dfcloud/internal/resource_model"
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DatastoreDataSource struct {
+	client *dfcloud.Client
+}
+
+func NewDatastoreDataSource() datasource.DataSource {
+	return &DatastoreDataSource{}
+}
+
+func (d *DatastoreDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "dfcloud_datastore"
+}
+
+func (d *DatastoreDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := datastoreDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the datastore. Exactly one of `id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the datastore. Exactly one of `id` or `name` must be set. The name must match exactly one datastore.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing Dragonfly datastore by ID or name.",
+		Attributes:          attributes,
+	}
+}
+
+// datastoreDataSourceAttributes returns the computed attributes of a
+// datastore in data sources, matching the dfcloud_datastore resource.
+func datastoreDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the datastore.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the datastore.",
+			Computed:            true,
+		},
+		"created_at": schema.Int64Attribute{
+			MarkdownDescription: "The timestamp when the datastore was created.",
+			Computed:            true,
+		},
+		"password": schema.StringAttribute{
+			MarkdownDescription: "The password for the datastore.",
+			Computed:            true,
+			Sensitive:           true,
+		},
+		"addr": schema.StringAttribute{
+			MarkdownDescription: "The address of the datastore.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The status of the datastore.",
+			Computed:            true,
+		},
+		"status_detail": schema.StringAttribute{
+			MarkdownDescription: "Additional details about the datastore status.",
+			Computed:            true,
+		},
+		"dashboard_url": schema.StringAttribute{
+			MarkdownDescription: "The URL of the datastore Grafana dashboard.",
+			Computed:            true,
+		},
+		"network_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the network the datastore is placed into.",
+			Computed:            true,
+		},
+		"byoc_account_id": schema.StringAttribute{
+			MarkdownDescription: "The BYOC (Bring Your Own Cloud) account ID the datastore is provisioned into.",
+			Computed:            true,
+		},
+		"cluster": schema.SingleNestedAttribute{
+			MarkdownDescription: "The cluster configuration for the datastore. Null if the datastore isn't a cluster.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"shard_memory": schema.Int64Attribute{
+					MarkdownDescription: "The cluster shard memory in bytes.",
+					Computed:            true,
+				},
+			},
+		},
+		"location": schema.SingleNestedAttribute{
+			MarkdownDescription: "The location configuration for the datastore.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"provider": schema.StringAttribute{
+					MarkdownDescription: "The provider for the datastore location.",
+					Computed:            true,
+				},
+				"region": schema.StringAttribute{
+					MarkdownDescription: "The region for the datastore location.",
+					Computed:            true,
+				},
+				"availability_zones": schema.ListAttribute{
+					MarkdownDescription: "The availability zones for the datastore location.",
+					ElementType:         types.StringType,
+					Computed:            true,
+				},
+			},
+		},
+		"tier": schema.SingleNestedAttribute{
+			MarkdownDescription: "The tier configuration for the datastore.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"max_memory_bytes": schema.Int64Attribute{
+					MarkdownDescription: "The maximum memory (in bytes) for the datastore.",
+					Computed:            true,
+				},
+				"performance_tier": schema.StringAttribute{
+					MarkdownDescription: "The performance tier for the datastore.",
+					Computed:            true,
+				},
+				"replicas": schema.Int64Attribute{
+					MarkdownDescription: "The number of replicas for the datastore.",
+					Computed:            true,
+				},
+				"byoc_instance_family_name": schema.StringAttribute{
+					MarkdownDescription: "The instance family name used for BYOC datastores.",
+					Computed:            true,
+				},
+			},
+		},
+		"dragonfly": schema.SingleNestedAttribute{
+			MarkdownDescription: "Dragonfly-specific configuration.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"cache_mode": schema.BoolAttribute{
+					MarkdownDescription: "Whether cache mode is enabled.",
+					Computed:            true,
+				},
+				"bullmq": schema.BoolAttribute{
+					MarkdownDescription: "Whether BullMQ compatibility is enabled.",
+					Computed:            true,
+				},
+				"tls": schema.BoolAttribute{
+					MarkdownDescription: "Whether TLS is enabled.",
+					Computed:            true,
+				},
+				"sidekiq": schema.BoolAttribute{
+					MarkdownDescription: "Whether Sidekiq compatibility is enabled.",
+					Computed:            true,
+				},
+				"memcached": schema.BoolAttribute{
+					MarkdownDescription: "Whether the Memcached protocol is enabled.",
+					Computed:            true,
+				},
+				"acl_rules": schema.ListAttribute{
+					MarkdownDescription: "List of ACL rules.",
+					ElementType:         types.StringType,
+					Computed:            true,
+					Sensitive:           true,
+				},
+			},
+		},
+		"maintenance_window": schema.SingleNestedAttribute{
+			MarkdownDescription: "The maintenance window configuration for the datastore.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"weekday": schema.Int64Attribute{
+					MarkdownDescription: "The day of the week the maintenance window starts. 0-6, 0 is Sunday.",
+					Computed:            true,
+				},
+				"hour": schema.Int64Attribute{
+					MarkdownDescription: "The hour of the day the maintenance window starts. 0-23.",
+					Computed:            true,
+				},
+				"duration_hours": schema.Int64Attribute{
+					MarkdownDescription: "The duration of the maintenance window in hours. 0 means maintenance is always allowed.",
+					Computed:            true,
+				},
+			},
+		},
+		"backup_policy": schema.SingleNestedAttribute{
+			MarkdownDescription: "The backup policy for the datastore.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"enabled": schema.BoolAttribute{
+					MarkdownDescription: "Whether scheduled backups are enabled.",
+					Computed:            true,
+				},
+				"retention": schema.Int64Attribute{
+					MarkdownDescription: "The number of days backups are kept for.",
+					Computed:            true,
+				},
+				"every_hour": schema.BoolAttribute{
+					MarkdownDescription: "Whether a backup is taken every hour.",
+					Computed:            true,
+				},
+				"every_day": schema.BoolAttribute{
+					MarkdownDescription: "Whether a backup is taken every day at the given `hours`.",
+					Computed:            true,
+				},
+				"hours": schema.ListAttribute{
+					MarkdownDescription: "The hours of the day backups are taken at. 0-23.",
+					ElementType:         types.Int64Type,
+					Computed:            true,
+				},
+				"weekdays": schema.ListAttribute{
+					MarkdownDescription: "The days of the week backups are taken on. 0-6, 0 is Sunday.",
+					ElementType:         types.Int64Type,
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func (d *DatastoreDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dfcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError("failed to get provider", "failed to get provider")
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DatastoreDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var id, name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() || id.IsUnknown() || name.IsUnknown() {
+		return
+	}
+
+	if id.IsNull() == name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Datastore Lookup",
+			"Exactly one of id or name must be set.",
+		)
+	}
+}
+
+func (d *DatastoreDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Only the lookup attributes are read from the config, since the
+	// computed attributes are null and can't be read into the model.
+	var id, name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		datastore *dfcloud.Datastore
+		err       error
+	)
+	if !id.IsNull() {
+		datastore, err = d.client.GetDatastore(ctx, id.ValueString())
+		if errors.Is(err, dfcloud.ErrNotFound) {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "datastore not found", fmt.Sprintf("No datastore has ID %q.", id.ValueString()))
+			return
+		}
+	} else {
+		datastore, err = d.findDatastoreByName(ctx, name.ValueString())
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "failed to read datastore", err)
+		return
+	}
+	if datastore == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "datastore not found", fmt.Sprintf("No datastore is named %q.", name.ValueString()))
+		return
+	}
+
+	var state resource_model.DatastoreDataSource
+	state.FromConfig(ctx, datastore)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// findDatastoreByName returns the datastore with the given name, or nil if
+// there is none. Datastores being deleted are ignored, so the name of a
+// deleted datastore can be reused.
+func (d *DatastoreDataSource) findDatastoreByName(ctx context.Context, name string) (*dfcloud.Datastore, error) {
+	datastores, err := d.client.ListDatastores(ctx, &dfcloud.ListDatastoresOptions{NamePrefix: name})
+	if err != nil {
+		return nil, err
+	}
+
+	var found *dfcloud.Datastore
+	for _, ds := range datastores {
+		if ds.Config.Name != name || ds.Status == dfcloud.DatastoreStatusDeleting || ds.Status == dfcloud.DatastoreStatusDeleted {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("multiple datastores are named %q (%s and %s), look up the datastore by id instead", name, found.ID, ds.ID)
+		}
+		found = ds
+	}
+	return found, nil
+}
+
+var (
+	_ datasource.DataSourceWithConfigure      = &DatastoreDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &DatastoreDataSource{}
+)
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnit_DatastoreDataSource(t *testing.T) {
+	_, providerConfig := testUnitFakeAPI(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccDatastoreResourceConfig("tf-test") + `
+data "dfcloud_datastore" "by_id" {
+  id = dfcloud_datastore.test.id
+}
+
+data "dfcloud_datastore" "by_name" {
+  name = dfcloud_datastore.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.dfcloud_datastore.by_id", "name", "dfcloud_datastore.test", "name"),
+					resource.TestCheckResourceAttrPair("data.dfcloud_datastore.by_id", "addr", "dfcloud_datastore.test", "addr"),
+					resource.TestCheckResourceAttrPair("data.dfcloud_datastore.by_id", "password", "dfcloud_datastore.test", "password"),
+					resource.TestCheckResourceAttrPair("data.dfcloud_datastore.by_id", "tier.max_memory_bytes", "dfcloud_datastore.test", "tier.max_memory_bytes"),
+					resource.TestCheckResourceAttr("data.dfcloud_datastore.by_id", "status", "active"),
+					resource.TestCheckResourceAttr("data.dfcloud_datastore.by_id", "location.provider", "aws"),
+					resource.TestCheckResourceAttrPair("data.dfcloud_datastore.by_name", "id", "dfcloud_datastore.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnit_DatastoreDataSource_notFound(t *testing.T) {
+	_, providerConfig := testUnitFakeAPI(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "dfcloud_datastore" "test" {
+  name = "missing"
+}
+`,
+				ExpectError: regexp.MustCompile(`No datastore is named "missing"`),
+			},
+		},
+	})
+}
+
+func TestUnit_DatastoreDataSource_read(t *testing.T) {
+	p := newTestUnitProvider(t)
+
+	// The names share a prefix, so looking up tf-test lists both.
+	var ids []string
+	for _, name := range []string{"tf-test", "tf-test-2", "tf-dup", "tf-dup"} {
+		state, err := p.apply("dfcloud_datastore", tftypes.Value{}, testUnitDatastoreConfig(name))
+		if err != nil {
+			t.Fatalf("create datastore %s error = %v", name, err)
+		}
+		ids = append(ids, testUnitAttr(t, state, "id"))
+	}
+
+	for _, config := range []map[string]any{
+		{"id": ids[0]},
+		{"name": "tf-test"},
+	} {
+		state, err := p.readDataSource("dfcloud_datastore", config)
+		if err != nil {
+			t.Fatalf("read %v error = %v", config, err)
+		}
+		testUnitCheckAttrs(t, state, map[string]string{
+			"id":                    ids[0],
+			"name":                  "tf-test",
+			"status":                "active",
+			"location.provider":     "aws",
+			"location.region":       "eu-west-1",
+			"tier.max_memory_bytes": "3000000000",
+			"tier.replicas":         "1",
+		})
+		if testUnitAttr(t, state, "password") == "" {
+			t.Errorf("read %v: password is not set", config)
+		}
+	}
+
+	tests := []struct {
+		config  map[string]any
+		wantErr string
+	}{
+		{
+			config:  map[string]any{"id": "dst_missing"},
+			wantErr: `No datastore has ID "dst_missing"`,
+		},
+		{
+			config:  map[string]any{"name": "missing"},
+			wantErr: `No datastore is named "missing"`,
+		},
+		{
+			config:  map[string]any{"name": "tf-dup"},
+			wantErr: `multiple datastores are named "tf-dup"`,
+		},
+		{
+			config:  map[string]any{"id": ids[0], "name": "tf-test"},
+			wantErr: "Exactly one of id or name must be set",
+		},
+	}
+	for _, tt := range tests {
+		if _, err := p.readDataSource("dfcloud_datastore", tt.config); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("read %v error = %v, want %q", tt.config, err, tt.wantErr)
+		}
+	}
+}
@@ -5,12 +5,19 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk/fake"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/samber/lo"
 )
 
 func testCheckDatastoreExists(n string) resource.TestCheckFunc {
@@ -71,6 +78,7 @@ func TestAcc_DatastoreResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testCheckDatastoreExists("dfcloud_datastore.test"),
 					resource.TestCheckResourceAttr("dfcloud_datastore.test", "name", name),
+					resource.TestCheckResourceAttr("dfcloud_datastore.test", "status", "active"),
 					resource.TestCheckResourceAttr("dfcloud_datastore.test", "location.provider", "aws"),
 					resource.TestCheckResourceAttr("dfcloud_datastore.test", "location.region", "eu-west-1"),
 					resource.TestCheckResourceAttr("dfcloud_datastore.test", "location.availability_zones.#", "1"),
@@ -226,3 +234,310 @@ resource "dfcloud_datastore" "test" {
 }
 `, name)
 }
+
+func testUnitCheckDatastoreDestroy(api *fake.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "dfcloud_datastore" {
+				continue
+			}
+			if _, ok := api.Datastore(rs.Primary.ID); ok {
+				return fmt.Errorf("datastore %s still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func TestUnit_DatastoreResource(t *testing.T) {
+	api, providerConfig := testUnitFakeAPI(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testUnitCheckDatastoreDestroy(api),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccDatastoreResourceConfig("tf-test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dfcloud_datastore.test", "name", "tf-test"),
+					resource.TestCheckResourceAttr("dfcloud_datastore.test", "status", "active"),
+					resource.TestCheckResourceAttr("dfcloud_datastore.test", "tier.replicas", "1"),
+					resource.TestCheckResourceAttrSet("dfcloud_datastore.test", "id"),
+					resource.TestCheckResourceAttrSet("dfcloud_datastore.test", "addr"),
+					resource.TestCheckResourceAttrSet("dfcloud_datastore.test", "password"),
+				),
+			},
+			{
+				ResourceName:      "dfcloud_datastore.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerConfig + testAccDatastoreResourceConfigUpdated("tf-test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dfcloud_datastore.test", "status", "active"),
+					resource.TestCheckResourceAttr("dfcloud_datastore.test", "tier.replicas", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testUnitDatastoreConfig(name string) map[string]any {
+	return map[string]any{
+		"name": name,
+		"location": map[string]any{
+			"provider": "aws",
+			"region":   "eu-west-1",
+		},
+		"tier": map[string]any{
+			"max_memory_bytes": 3000000000,
+			"performance_tier": "dev",
+			"replicas":         1,
+		},
+	}
+}
+
+// testUnitCreatePendingDatastore creates a datastore whose create times out
+// while it is still pending, with the given timeouts in addition to the
+// create timeout.
+func testUnitCreatePendingDatastore(t *testing.T, timeouts map[string]any) (*testUnitProvider, *testUnitClock, tftypes.Value) {
+	t.Helper()
+
+	clock := newTestUnitClock()
+	p := newTestUnitProvider(t, fake.WithDelay(time.Hour), fake.WithClock(clock.Now))
+
+	config := testUnitDatastoreConfig("tf-test")
+	config["timeouts"] = map[string]any{"create": "1ms"}
+	for k, v := range timeouts {
+		config["timeouts"].(map[string]any)[k] = v
+	}
+	state, err := p.apply("dfcloud_datastore", tftypes.Value{}, config)
+	if err == nil || !strings.Contains(err.Error(), "terraform untaint") {
+		t.Fatalf("create error = %v, want a timeout explaining the datastore is tainted", err)
+	}
+	if state.IsNull() {
+		t.Fatalf("create didn't save the datastore to state")
+	}
+	testUnitCheckAttrs(t, state, map[string]string{
+		"name":   "tf-test",
+		"status": "pending",
+	})
+	if _, ok := p.api.Datastore(testUnitAttr(t, state, "id")); !ok {
+		t.Fatalf("datastore in state doesn't exist")
+	}
+	return p, clock, state
+}
+
+func TestUnit_DatastoreResource_readResumesCreate(t *testing.T) {
+	p, clock, state := testUnitCreatePendingDatastore(t, nil)
+
+	// The datastore is still pending on the first request of the refresh,
+	// and active on the next.
+	clock.setStep(40 * time.Minute)
+	state, err := p.refresh("dfcloud_datastore", state)
+	if err != nil {
+		t.Fatalf("refresh error = %v", err)
+	}
+	testUnitCheckAttrs(t, state, map[string]string{
+		"status": "active",
+	})
+	if testUnitAttr(t, state, "addr") == "" {
+		t.Errorf("addr is not set")
+	}
+}
+
+func TestUnit_DatastoreResource_readTimesOutPending(t *testing.T) {
+	p, _, state := testUnitCreatePendingDatastore(t, map[string]any{"read": "100ms"})
+
+	// The clock is stopped, so the datastore stays pending until the read
+	// times out, and is then read as is.
+	state, err := p.refresh("dfcloud_datastore", state)
+	if err != nil {
+		t.Fatalf("refresh error = %v", err)
+	}
+	testUnitCheckAttrs(t, state, map[string]string{
+		"name":   "tf-test",
+		"status": "pending",
+	})
+}
+
+func TestUnit_DatastoreResource_readDoesNotWaitForUpdate(t *testing.T) {
+	clock := newTestUnitClock()
+	clock.setStep(40 * time.Minute)
+	p := newTestUnitProvider(t, fake.WithDelay(time.Hour), fake.WithClock(clock.Now))
+
+	state, err := p.apply("dfcloud_datastore", tftypes.Value{}, testUnitDatastoreConfig("tf-test"))
+	if err != nil {
+		t.Fatalf("create error = %v", err)
+	}
+	id := testUnitAttr(t, state, "id")
+
+	// Resize the datastore outside Terraform, and stop the clock so the
+	// update never finishes.
+	clock.setStep(0)
+	ds, err := p.client.GetDatastore(context.Background(), id)
+	if err != nil {
+		t.Fatalf("GetDatastore() error = %v", err)
+	}
+	ds.Config.Tier.Replicas = lo.ToPtr(2)
+	if _, err := p.client.UpdateDatastore(context.Background(), id, &ds.Config); err != nil {
+		t.Fatalf("UpdateDatastore() error = %v", err)
+	}
+
+	state, err = p.refresh("dfcloud_datastore", state)
+	if err != nil {
+		t.Fatalf("refresh error = %v", err)
+	}
+	testUnitCheckAttrs(t, state, map[string]string{
+		"status":        "updating",
+		"tier.replicas": "2",
+	})
+}
+
+func TestUnit_DatastoreResource_updateWaitsForPending(t *testing.T) {
+	p, clock, state := testUnitCreatePendingDatastore(t, nil)
+
+	clock.setStep(40 * time.Minute)
+	config := testUnitDatastoreConfig("tf-test-updated")
+	config["timeouts"] = map[string]any{"create": "1ms"}
+	state, err := p.apply("dfcloud_datastore", state, config)
+	if err != nil {
+		t.Fatalf("update error = %v", err)
+	}
+	testUnitCheckAttrs(t, state, map[string]string{
+		"name":   "tf-test-updated",
+		"status": "active",
+	})
+}
+
+func TestUnit_DatastoreResource_backupPolicySwitchesSchedule(t *testing.T) {
+	p := newTestUnitProvider(t)
+
+	steps := []struct {
+		policy    map[string]any
+		everyHour bool
+		everyDay  bool
+		hours     []int
+		weekdays  []int
+	}{
+		{
+			policy:    map[string]any{"enabled": true, "every_hour": true},
+			everyHour: true,
+		},
+		{
+			policy:   map[string]any{"enabled": true, "every_day": true, "hours": []any{3}},
+			everyDay: true,
+			hours:    []int{3},
+		},
+		{
+			policy:   map[string]any{"enabled": true, "hours": []any{3}, "weekdays": []any{1}},
+			hours:    []int{3},
+			weekdays: []int{1},
+		},
+		{
+			policy:    map[string]any{"enabled": true, "every_hour": true},
+			everyHour: true,
+		},
+	}
+
+	var state tftypes.Value
+	for i, step := range steps {
+		config := testUnitDatastoreConfig("tf-test")
+		config["backup_policy"] = step.policy
+		var err error
+		state, err = p.apply("dfcloud_datastore", state, config)
+		if err != nil {
+			t.Fatalf("step %d: apply error = %v", i, err)
+		}
+		testUnitCheckAttrs(t, state, map[string]string{
+			"backup_policy.every_hour": strconv.FormatBool(step.everyHour),
+			"backup_policy.every_day":  strconv.FormatBool(step.everyDay),
+			"backup_policy.hours.#":    strconv.Itoa(len(step.hours)),
+			"backup_policy.weekdays.#": strconv.Itoa(len(step.weekdays)),
+		})
+
+		ds, ok := p.api.Datastore(testUnitAttr(t, state, "id"))
+		if !ok {
+			t.Fatalf("step %d: datastore doesn't exist", i)
+		}
+		policy := ds.Config.BackupPolicy
+		if policy == nil {
+			t.Fatalf("step %d: datastore has no backup policy", i)
+		}
+		if got := lo.FromPtr(policy.EveryHour); got != step.everyHour {
+			t.Errorf("step %d: every_hour = %v, want %v", i, got, step.everyHour)
+		}
+		if got := lo.FromPtr(policy.EveryDay); got != step.everyDay {
+			t.Errorf("step %d: every_day = %v, want %v", i, got, step.everyDay)
+		}
+		if !slices.Equal(policy.Hours, step.hours) {
+			t.Errorf("step %d: hours = %v, want %v", i, policy.Hours, step.hours)
+		}
+		if !slices.Equal(policy.WeekDays, step.weekdays) {
+			t.Errorf("step %d: weekdays = %v, want %v", i, policy.WeekDays, step.weekdays)
+		}
+	}
+}
+
+func TestUnit_DatastoreResource_restoreFromBackup(t *testing.T) {
+	p := newTestUnitProvider(t)
+
+	state, err := p.apply("dfcloud_datastore", tftypes.Value{}, testUnitDatastoreConfig("tf-test"))
+	if err != nil {
+		t.Fatalf("create error = %v", err)
+	}
+	id := testUnitAttr(t, state, "id")
+	backup, err := p.apply("dfcloud_backup", tftypes.Value{}, map[string]any{"datastore_id": id})
+	if err != nil {
+		t.Fatalf("create backup error = %v", err)
+	}
+	backupID := testUnitAttr(t, backup, "id")
+
+	// Setting the backup restores it, and waits for the datastore to finish
+	// restoring and load the backup.
+	config := testUnitDatastoreConfig("tf-test")
+	config["restore_from_backup_id"] = backupID
+	state, err = p.apply("dfcloud_datastore", state, config)
+	if err != nil {
+		t.Fatalf("restore error = %v", err)
+	}
+	testUnitCheckAttrs(t, state, map[string]string{
+		"status":                 "active",
+		"restore_from_backup_id": backupID,
+	})
+	ds, _ := p.api.Datastore(id)
+	if ds.Status != dfcloud.DatastoreStatusActive || ds.Config.Restore.BackupId != backupID || !ds.Config.Restore.Loaded {
+		t.Fatalf("datastore is %s with restore %+v, want active with backup %s loaded", ds.Status, ds.Config.Restore, backupID)
+	}
+
+	// Other updates don't restore the backup again. The API rejects restoring
+	// a deleted backup, so the update fails if it is requested.
+	if err := p.destroy("dfcloud_backup", backup); err != nil {
+		t.Fatalf("destroy backup error = %v", err)
+	}
+	config["name"] = "tf-test-updated"
+	state, err = p.apply("dfcloud_datastore", state, config)
+	if err != nil {
+		t.Fatalf("update error = %v", err)
+	}
+	testUnitCheckAttrs(t, state, map[string]string{
+		"name":                   "tf-test-updated",
+		"status":                 "active",
+		"restore_from_backup_id": backupID,
+	})
+
+	// Removing the backup doesn't restore anything either.
+	delete(config, "restore_from_backup_id")
+	state, err = p.apply("dfcloud_datastore", state, config)
+	if err != nil {
+		t.Fatalf("update error = %v", err)
+	}
+	testUnitCheckAttrs(t, state, map[string]string{
+		"status": "active",
+	})
+	ds, _ = p.api.Datastore(id)
+	if ds.Config.Restore.BackupId != backupID || !ds.Config.Restore.Loaded {
+		t.Errorf("restore = %+v, want backup %s still loaded", ds.Config.Restore, backupID)
+	}
+}
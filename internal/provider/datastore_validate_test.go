@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDatastoreValidateConfigBackupPolicy(t *testing.T) {
+	hours := func(v ...int64) types.List {
+		var elems []attr.Value
+		for _, h := range v {
+			elems = append(elems, types.Int64Value(h))
+		}
+		return types.ListValueMust(types.Int64Type, elems)
+	}
+	nullHours := types.ListNull(types.Int64Type)
+
+	tests := map[string]struct {
+		everyHour bool
+		everyDay  bool
+		hours     types.List
+		weekDays  types.List
+		wantErr   bool
+	}{
+		"every hour":                {everyHour: true, hours: nullHours, weekDays: nullHours},
+		"every day at hours":        {everyDay: true, hours: hours(2, 14), weekDays: nullHours},
+		"weekdays at hours":         {hours: hours(0, 23), weekDays: hours(0, 6)},
+		"hour out of range":         {everyDay: true, hours: hours(24), weekDays: nullHours, wantErr: true},
+		"weekday out of range":      {hours: hours(1), weekDays: hours(7), wantErr: true},
+		"every hour with hours":     {everyHour: true, hours: hours(1), weekDays: nullHours, wantErr: true},
+		"every hour with every day": {everyHour: true, everyDay: true, hours: nullHours, weekDays: nullHours, wantErr: true},
+		"every day with weekdays":   {everyDay: true, hours: hours(1), weekDays: hours(1), wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &datastoreResource{}
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			diags := state.SetAttribute(ctx, path.Root("backup_policy"), types.ObjectValueMust(
+				map[string]attr.Type{
+					"enabled":    types.BoolType,
+					"retention":  types.Int64Type,
+					"every_hour": types.BoolType,
+					"every_day":  types.BoolType,
+					"hours":      types.ListType{ElemType: types.Int64Type},
+					"weekdays":   types.ListType{ElemType: types.Int64Type},
+				},
+				map[string]attr.Value{
+					"enabled":    types.BoolValue(true),
+					"retention":  types.Int64Null(),
+					"every_hour": types.BoolValue(tt.everyHour),
+					"every_day":  types.BoolValue(tt.everyDay),
+					"hours":      tt.hours,
+					"weekdays":   tt.weekDays,
+				},
+			))
+			if diags.HasError() {
+				t.Fatalf("set backup_policy: %v", diags)
+			}
+
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw},
+			}, &resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Fatalf("ValidateConfig() has error = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
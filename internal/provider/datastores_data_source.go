@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/dragonflydb/terraform-provider-dfcloud/internal/resource_model"
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DatastoresDataSource struct {
+	client *dfcloud.Client
+}
+
+func NewDatastoresDataSource() datasource.DataSource {
+	return &DatastoresDataSource{}
+}
+
+func (d *DatastoresDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "dfcloud_datastores"
+}
+
+func (d *DatastoresDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Dragonfly datastores, ordered by name and then ID.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only include datastores whose name matches the [regular expression](https://github.com/google/re2/wiki/Syntax).",
+				Optional:            true,
+			},
+			"cloud_provider": schema.StringAttribute{
+				MarkdownDescription: "Only include datastores in the given cloud provider, such as `aws`.",
+				Optional:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Only include datastores in the given region.",
+				Optional:            true,
+			},
+			"performance_tier": schema.StringAttribute{
+				MarkdownDescription: "Only include datastores in the given performance tier.",
+				Optional:            true,
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Only include datastores placed into the given network.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only include datastores with the given status.",
+				Optional:            true,
+			},
+			"byoc_account_id": schema.StringAttribute{
+				MarkdownDescription: "Only include datastores provisioned into the given BYOC account.",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matching datastores, in the same order as `datastores`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"datastores": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching datastores, ordered by name and then ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: datastoreDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *DatastoresDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dfcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError("failed to get provider", "failed to get provider")
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DatastoresDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var nameRegex types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+	if resp.Diagnostics.HasError() || nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Regular Expression",
+			fmt.Sprintf("name_regex is not a valid regular expression: %s.", err),
+		)
+	}
+}
+
+func (d *DatastoresDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state resource_model.Datastores
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+	}
+
+	datastores, err := d.client.ListDatastores(ctx, resource_model.IntoListDatastoresOptions(state))
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "failed to list datastores", err)
+		return
+	}
+
+	if nameRegex != nil {
+		datastores = slices.DeleteFunc(datastores, func(ds *dfcloud.Datastore) bool {
+			return !nameRegex.MatchString(ds.Config.Name)
+		})
+	}
+	// Sort so adding a datastore doesn't reorder the others in the plan.
+	slices.SortStableFunc(datastores, func(a, b *dfcloud.Datastore) int {
+		return cmp.Or(cmp.Compare(a.Config.Name, b.Config.Name), cmp.Compare(a.ID, b.ID))
+	})
+
+	state.IDs = make([]types.String, 0, len(datastores))
+	state.Datastores = make([]*resource_model.DatastoreDataSource, 0, len(datastores))
+	for _, ds := range datastores {
+		var model resource_model.DatastoreDataSource
+		model.FromConfig(ctx, ds)
+		state.IDs = append(state.IDs, model.ID)
+		state.Datastores = append(state.Datastores, &model)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+var (
+	_ datasource.DataSourceWithConfigure      = &DatastoresDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &DatastoresDataSource{}
+)
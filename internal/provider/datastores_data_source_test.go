@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnit_DatastoresDataSource(t *testing.T) {
+	_, providerConfig := testUnitFakeAPI(t)
+
+	datastores := `
+resource "dfcloud_datastore" "b" {
+  name = "tf-test-b"
+
+  location = {
+    provider = "aws"
+    region   = "eu-west-1"
+  }
+
+  tier = {
+    max_memory_bytes = 3e9
+    performance_tier = "dev"
+  }
+}
+
+resource "dfcloud_datastore" "a" {
+  name = "tf-test-a"
+
+  location = {
+    provider = "aws"
+    region   = "eu-west-1"
+  }
+
+  tier = {
+    max_memory_bytes = 12500000000
+    performance_tier = "standard"
+  }
+}
+
+resource "dfcloud_datastore" "other" {
+  name = "other"
+
+  location = {
+    provider = "gcp"
+    region   = "us-central1"
+  }
+
+  tier = {
+    max_memory_bytes = 3e9
+    performance_tier = "dev"
+  }
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + datastores + `
+data "dfcloud_datastores" "test" {
+  name_regex     = "^tf-test-"
+  cloud_provider = "aws"
+
+  depends_on = [dfcloud_datastore.a, dfcloud_datastore.b, dfcloud_datastore.other]
+}
+
+data "dfcloud_datastores" "dev" {
+  performance_tier = "dev"
+
+  depends_on = [dfcloud_datastore.a, dfcloud_datastore.b, dfcloud_datastore.other]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dfcloud_datastores.test", "datastores.#", "2"),
+					resource.TestCheckResourceAttr("data.dfcloud_datastores.test", "datastores.0.name", "tf-test-a"),
+					resource.TestCheckResourceAttr("data.dfcloud_datastores.test", "datastores.1.name", "tf-test-b"),
+					resource.TestCheckResourceAttrPair("data.dfcloud_datastores.test", "ids.0", "dfcloud_datastore.a", "id"),
+					resource.TestCheckResourceAttrPair("data.dfcloud_datastores.test", "datastores.0.addr", "dfcloud_datastore.a", "addr"),
+					resource.TestCheckResourceAttr("data.dfcloud_datastores.dev", "ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnit_DatastoresDataSource_invalidRegex(t *testing.T) {
+	_, providerConfig := testUnitFakeAPI(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "dfcloud_datastores" "test" {
+  name_regex = "("
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Regular Expression`),
+			},
+		},
+	})
+}
+
+func TestUnit_DatastoresDataSource_read(t *testing.T) {
+	p := newTestUnitProvider(t)
+
+	ids := map[string]string{}
+	for _, ds := range []struct {
+		name, provider, region, tier string
+		memory                       int
+	}{
+		{"tf-test-b", "aws", "eu-west-1", "dev", 3000000000},
+		{"tf-test-a", "aws", "us-east-1", "standard", 12500000000},
+		{"tf-test-c", "gcp", "us-central1", "dev", 3000000000},
+		{"other", "aws", "eu-west-1", "dev", 3000000000},
+	} {
+		state, err := p.apply("dfcloud_datastore", tftypes.Value{}, map[string]any{
+			"name": ds.name,
+			"location": map[string]any{
+				"provider": ds.provider,
+				"region":   ds.region,
+			},
+			"tier": map[string]any{
+				"max_memory_bytes": ds.memory,
+				"performance_tier": ds.tier,
+			},
+		})
+		if err != nil {
+			t.Fatalf("create datastore %s error = %v", ds.name, err)
+		}
+		ids[ds.name] = testUnitAttr(t, state, "id")
+	}
+
+	tests := []struct {
+		config map[string]any
+		want   []string
+	}{
+		{
+			config: map[string]any{},
+			want:   []string{"other", "tf-test-a", "tf-test-b", "tf-test-c"},
+		},
+		{
+			config: map[string]any{"name_regex": "^tf-test-"},
+			want:   []string{"tf-test-a", "tf-test-b", "tf-test-c"},
+		},
+		{
+			config: map[string]any{"name_regex": "^tf-test-", "cloud_provider": "aws"},
+			want:   []string{"tf-test-a", "tf-test-b"},
+		},
+		{
+			config: map[string]any{"region": "eu-west-1"},
+			want:   []string{"other", "tf-test-b"},
+		},
+		{
+			config: map[string]any{"performance_tier": "dev", "cloud_provider": "aws"},
+			want:   []string{"other", "tf-test-b"},
+		},
+		{
+			config: map[string]any{"status": "active", "name_regex": "c$"},
+			want:   []string{"tf-test-c"},
+		},
+		{
+			config: map[string]any{"status": "pending"},
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		state, err := p.readDataSource("dfcloud_datastores", tt.config)
+		if err != nil {
+			t.Fatalf("read %v error = %v", tt.config, err)
+		}
+		checks := map[string]string{
+			"ids.#":        strconv.Itoa(len(tt.want)),
+			"datastores.#": strconv.Itoa(len(tt.want)),
+		}
+		for i, name := range tt.want {
+			checks[fmt.Sprintf("ids.%d", i)] = ids[name]
+			checks[fmt.Sprintf("datastores.%d.name", i)] = name
+			checks[fmt.Sprintf("datastores.%d.status", i)] = "active"
+		}
+		testUnitCheckAttrs(t, state, checks)
+	}
+
+	if _, err := p.readDataSource("dfcloud_datastores", map[string]any{"name_regex": "("}); err == nil || !strings.Contains(err.Error(), "Invalid Regular Expression") {
+		t.Errorf("read with an invalid regex error = %v, want Invalid Regular Expression", err)
+	}
+}
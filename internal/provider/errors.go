@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// addAPIError adds an error diagnostic for err, explaining the common API
+// failures so users don't have to decode status codes.
+func addAPIError(diags *diag.Diagnostics, summary string, err error) {
+	diags.AddError(summary, apiErrorDetail(err))
+}
+
+// addCreateWaitError adds an error diagnostic for a resource of the given
+// kind that was created but failed while waiting for it to be ready.
+//
+// Resources are saved to state before waiting, so if the wait fails
+// Terraform still tracks the resource rather than orphaning it. Terraform
+// taints resources whose create fails, so the next apply replaces it unless
+// it's untainted.
+func addCreateWaitError(diags *diag.Diagnostics, summary string, kind string, err error) {
+	detail := apiErrorDetail(err)
+	detail += fmt.Sprintf("\n\nThe %[1]s was created and saved to state, but is marked as tainted, so the next apply replaces it. To keep the %[1]s instead, run terraform untaint on it before applying again.", kind)
+	diags.AddError(summary, detail)
+}
+
+// apiErrorDetail returns the detail of the diagnostic for err.
+func apiErrorDetail(err error) string {
+	detail := err.Error()
+
+	switch {
+	case errors.Is(err, dfcloud.ErrUnauthorized):
+		detail += "\n\nThe API key was rejected. Check the provider api_key or the DFCLOUD_API_KEY environment variable."
+	case errors.Is(err, dfcloud.ErrForbidden):
+		detail += "\n\nThe API key is not permitted to perform this operation."
+	case errors.Is(err, dfcloud.ErrConflict):
+		detail += "\n\nThe request conflicts with the current state of the resource, for example another operation is still in progress. Wait for it to complete and try again."
+	case errors.Is(err, dfcloud.ErrValidation):
+		detail += "\n\nThe API rejected the configuration. Check the values above and try again."
+	case errors.Is(err, dfcloud.ErrRateLimited):
+		detail += "\n\nThe API is rate limiting requests. Try again later, or increase the provider max_retries and max_backoff."
+	}
+
+	var apiErr *dfcloud.APIError
+	if errors.As(err, &apiErr) && apiErr.RequestID != "" {
+		detail += fmt.Sprintf("\n\nRequest ID: %s", apiErr.RequestID)
+	}
+	return detail
+}
+
+// addAPIErrorForPlan is like addAPIError, but reports field-level validation
+// errors against the matching attribute of plan so Terraform points at the
+// offending configuration.
+//
+// fieldAttributes maps API field paths to schema attribute paths where they
+// differ, such as "byoc.account_id" to "byoc_account_id". Fields that don't
+// match an attribute are reported in a single resource-level diagnostic.
+func addAPIErrorForPlan(ctx context.Context, diags *diag.Diagnostics, summary string, err error, plan tfsdk.Plan, fieldAttributes map[string]string) {
+	var apiErr *dfcloud.APIError
+	if !errors.As(err, &apiErr) || len(apiErr.FieldErrors()) == 0 {
+		addAPIError(diags, summary, err)
+		return
+	}
+
+	var (
+		attrDiags diag.Diagnostics
+		unmatched bool
+	)
+	for _, detail := range apiErr.FieldErrors() {
+		p, ok := attributePath(ctx, plan, detail.Path(), fieldAttributes)
+		if !ok {
+			unmatched = true
+			continue
+		}
+
+		reason := detail.Reason
+		if apiErr.RequestID != "" {
+			reason += fmt.Sprintf("\n\nRequest ID: %s", apiErr.RequestID)
+		}
+		attrDiags.AddAttributeError(p, summary, reason)
+	}
+
+	if unmatched {
+		addAPIError(diags, summary, err)
+	}
+	diags.Append(attrDiags...)
+}
+
+// attributePath converts the API field path segments into a path of an
+// attribute in the plan schema, returning false if there is no such
+// attribute.
+func attributePath(ctx context.Context, plan tfsdk.Plan, segments []string, fieldAttributes map[string]string) (path.Path, bool) {
+	if len(segments) == 0 || plan.Schema == nil {
+		return path.Empty(), false
+	}
+
+	// Rename the longest prefix of the field that has a different name in
+	// the schema.
+	for i := len(segments); i > 0; i-- {
+		if renamed, ok := fieldAttributes[strings.Join(segments[:i], ".")]; ok {
+			segments = append(strings.Split(renamed, "."), segments[i:]...)
+			break
+		}
+	}
+
+	// attrPath excludes list indexes, which can't be looked up in the schema.
+	p, attrPath := path.Empty(), path.Empty()
+	for i, s := range segments {
+		if idx, err := strconv.Atoi(s); err == nil && i > 0 {
+			p = p.AtListIndex(idx)
+			continue
+		}
+		p = p.AtName(s)
+		attrPath = attrPath.AtName(s)
+	}
+
+	if _, d := plan.Schema.AttributeAtPath(ctx, attrPath); d.HasError() {
+		return path.Empty(), false
+	}
+	return p, true
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestAddAPIErrorForPlan(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	NewDatastoreResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+
+	err := &dfcloud.APIError{
+		StatusCode: http.StatusUnprocessableEntity,
+		Message:    "invalid datastore config",
+		Details: []dfcloud.ErrorDetail{
+			{Field: "tier.max_memory_bytes", Reason: "not permitted for tier"},
+			{Field: "byoc.account_id", Reason: "unknown account"},
+			{Field: "location.availability_zones[1]", Reason: "unknown zone"},
+		},
+	}
+
+	var diags diag.Diagnostics
+	addAPIErrorForPlan(ctx, &diags, "Error Creating Datastore", err, plan, datastoreFieldAttributes)
+
+	want := []path.Path{
+		path.Root("tier").AtName("max_memory_bytes"),
+		path.Root("byoc_account_id"),
+		path.Root("location").AtName("availability_zones").AtListIndex(1),
+	}
+	if len(diags) != len(want) {
+		t.Fatalf("got %d diagnostics, want %d: %v", len(diags), len(want), diags)
+	}
+	for i, d := range diags {
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if !ok {
+			t.Fatalf("diagnostic %d has no attribute path: %v", i, d)
+		}
+		if !withPath.Path().Equal(want[i]) {
+			t.Errorf("diagnostic %d path = %s, want %s", i, withPath.Path(), want[i])
+		}
+	}
+}
+
+func TestAddAPIErrorForPlanUnknownField(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	NewDatastoreResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+
+	err := &dfcloud.APIError{
+		StatusCode: http.StatusBadRequest,
+		Details: []dfcloud.ErrorDetail{
+			{Field: "unknown_field", Reason: "not supported"},
+		},
+	}
+
+	var diags diag.Diagnostics
+	addAPIErrorForPlan(ctx, &diags, "Error Creating Datastore", err, plan, datastoreFieldAttributes)
+
+	if len(diags) != 1 {
+		t.Fatalf("got %d diagnostics, want 1: %v", len(diags), diags)
+	}
+	if _, ok := diags[0].(diag.DiagnosticWithPath); ok {
+		t.Fatalf("diagnostic should not have an attribute path: %v", diags[0])
+	}
+}
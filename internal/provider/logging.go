@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"os"
+
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiLogSubsystem is the tflog subsystem API requests are logged to. Its
+// level defaults to the provider level set with TF_LOG_PROVIDER_DFCLOUD and
+// can be overridden with TF_LOG_PROVIDER_DFCLOUD_API.
+const apiLogSubsystem = "api"
+
+// apiLogLevelEnv are the environment variables that set the level of the API
+// log subsystem, most specific first. TF_LOG_PROVIDER and TF_LOG are what
+// terraform filters provider logs with.
+var apiLogLevelEnv = []string{
+	"TF_LOG_PROVIDER_DFCLOUD_API",
+	"TF_LOG_PROVIDER_DFCLOUD",
+	"TF_LOG_PROVIDER",
+	"TF_LOG",
+}
+
+// apiLogLevel returns the level API requests are logged at, or [hclog.Off]
+// if logging isn't enabled.
+func apiLogLevel() hclog.Level {
+	for _, env := range apiLogLevelEnv {
+		v := os.Getenv(env)
+		if v == "" {
+			continue
+		}
+		// Terraform logs everything for unrecognised levels such as JSON.
+		if level := hclog.LevelFromString(v); level != hclog.NoLevel {
+			return level
+		}
+		return hclog.Trace
+	}
+	return hclog.Off
+}
+
+// requestLoggingOptions returns the client options to log API requests at
+// the configured level. Requests are only logged at debug level and below,
+// and bodies only at trace level, so the client doesn't buffer response
+// bodies that won't be logged.
+func requestLoggingOptions() []dfcloud.ClientOption {
+	level := apiLogLevel()
+	if level == hclog.Off || level > hclog.Debug {
+		return nil
+	}
+	return []dfcloud.ClientOption{
+		dfcloud.WithRequestLogger(logRequest),
+		dfcloud.WithRequestBodyLogging(level == hclog.Trace),
+	}
+}
+
+// logRequest logs API requests sent by the client. The SDK redacts secrets
+// before calling it.
+func logRequest(ctx context.Context, entry dfcloud.RequestLog) {
+	ctx = tflog.NewSubsystem(ctx, apiLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_DFCLOUD", apiLogSubsystem))
+
+	fields := map[string]any{
+		"method":      entry.Method,
+		"url":         entry.URL,
+		"attempt":     entry.Attempt,
+		"status_code": entry.StatusCode,
+		"duration_ms": entry.Duration.Milliseconds(),
+	}
+	if entry.Err != nil {
+		fields["error"] = entry.Err.Error()
+	}
+	tflog.SubsystemDebug(ctx, apiLogSubsystem, "sent API request", fields)
+
+	tflog.SubsystemTrace(ctx, apiLogSubsystem, "API request details", map[string]any{
+		"method":          entry.Method,
+		"url":             entry.URL,
+		"request_headers": entry.RequestHeaders,
+		"request_body":    entry.RequestBody,
+		"status_code":     entry.StatusCode,
+		"response_body":   entry.ResponseBody,
+	})
+}
+
+// logWaitProgress logs the progress of resources the provider is waiting
+// for, so long applies show what they are waiting on.
+func logWaitProgress(ctx context.Context, progress dfcloud.WaitProgress) {
+	fields := map[string]any{
+		"id":              progress.ID,
+		"status":          progress.Status,
+		"target_status":   progress.Target,
+		"elapsed_seconds": int(progress.Elapsed.Seconds()),
+	}
+	if progress.Detail != "" {
+		fields["status_detail"] = progress.Detail
+	}
+	tflog.Info(ctx, "waiting for "+progress.Kind, fields)
+}
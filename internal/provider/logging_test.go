@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestAPILogLevel(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want hclog.Level
+	}{
+		{
+			name: "unset",
+			want: hclog.Off,
+		},
+		{
+			name: "tf log",
+			env:  map[string]string{"TF_LOG": "debug"},
+			want: hclog.Debug,
+		},
+		{
+			name: "json",
+			env:  map[string]string{"TF_LOG": "json"},
+			want: hclog.Trace,
+		},
+		{
+			name: "subsystem overrides provider",
+			env: map[string]string{
+				"TF_LOG_PROVIDER":             "trace",
+				"TF_LOG_PROVIDER_DFCLOUD_API": "info",
+			},
+			want: hclog.Info,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, env := range apiLogLevelEnv {
+				t.Setenv(env, tt.env[env])
+			}
+			if got := apiLogLevel(); got != tt.want {
+				t.Fatalf("apiLogLevel() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
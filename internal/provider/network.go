@@ -7,6 +7,7 @@ import (
 
 	"github.com/dragonflydb/terraform-provider-dfcloud/internal/resource_model"
 	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -15,6 +16,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// networkFieldAttributes maps API field paths to the network schema
+// attributes where they differ.
+var networkFieldAttributes = map[string]string{
+	"byoc.account_id": "byoc_account_id",
+}
+
+// Default network timeouts, used unless overridden in the timeouts block.
+const (
+	defaultNetworkCreateTimeout = 15 * time.Minute
+	defaultNetworkReadTimeout   = 5 * time.Minute
+	defaultNetworkUpdateTimeout = 5 * time.Minute
+	defaultNetworkDeleteTimeout = 15 * time.Minute
+)
+
 type NetworkResource struct {
 	client *dfcloud.Client
 }
@@ -93,6 +108,9 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -121,20 +139,36 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 	networkConfig := resource_model.IntoNetworkConfig(state)
 	respNetwork, err := r.client.CreateNetwork(ctx, networkConfig)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to create network", err.Error())
+		addAPIErrorForPlan(ctx, &resp.Diagnostics, "failed to create network", err, req.Plan, networkFieldAttributes)
+		return
+	}
+
+	// Save before waiting, see addCreateWaitError.
+	configTimeouts := state.Timeouts
+	state = *resource_model.FromNetworkConfig(respNetwork)
+	state.Timeouts = configTimeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := configTimeouts.Create(ctx, defaultNetworkCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// wait until VPC IDs are created
-	waitForNetworkStatusCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	waitForNetworkStatusCtx, cancel := context.WithTimeout(ctx, createTimeout)
 	defer cancel()
-	respNetwork, err = resource_model.WaitUntilNetworkStatus(waitForNetworkStatusCtx, r.client, respNetwork.ID, dfcloud.NetworkStatusActive)
+	respNetwork, err = r.client.WaitForNetwork(waitForNetworkStatusCtx, respNetwork.ID, dfcloud.NetworkStatusActive)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to wait for network", err.Error())
+		addCreateWaitError(&resp.Diagnostics, "failed to wait for network", "network", err)
 		return
 	}
 
 	state = *resource_model.FromNetworkConfig(respNetwork)
+	state.Timeouts = configTimeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -148,34 +182,43 @@ func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, re
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultNetworkReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	respNetwork, err := r.client.GetNetwork(ctx, state.Id.ValueString())
 	if errors.Is(err, dfcloud.ErrNotFound) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("failed to read network", err.Error())
+		addAPIError(&resp.Diagnostics, "failed to read network", err)
 		return
 	}
 	if respNetwork.Status == dfcloud.NetworkStatusDeleted {
 		resp.State.RemoveResource(ctx)
 		return
 	}
-	if respNetwork.Status != dfcloud.NetworkStatusActive {
-		waitForNetworkStatusCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
-		defer cancel()
-		respNetwork, err = resource_model.WaitUntilNetworkStatus(waitForNetworkStatusCtx, r.client, state.Id.ValueString(), dfcloud.NetworkStatusActive)
+	// Failed networks are read as is, so they can still be destroyed.
+	if respNetwork.Status == dfcloud.NetworkStatusPending {
+		respNetwork, err = r.client.WaitForNetwork(ctx, state.Id.ValueString(), dfcloud.NetworkStatusActive)
 		if errors.Is(err, dfcloud.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 		if err != nil {
-			resp.Diagnostics.AddError("failed to wait for network", err.Error())
+			addAPIError(&resp.Diagnostics, "failed to wait for network", err)
 			return
 		}
 	}
 
+	configTimeouts := state.Timeouts
 	state = *resource_model.FromNetworkConfig(respNetwork)
+	state.Timeouts = configTimeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -192,6 +235,14 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultNetworkUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	networkConfig := &dfcloud.NetworkConfig{
 		Name: plan.Name.ValueString(),
 	}
@@ -201,7 +252,7 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("failed to update network", err.Error())
+		addAPIErrorForPlan(ctx, &resp.Diagnostics, "failed to update network", err, req.Plan, networkFieldAttributes)
 		return
 	}
 
@@ -209,7 +260,9 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 		"network_id": respNetwork.ID,
 	})
 
+	configTimeouts := plan.Timeouts
 	plan = *resource_model.FromNetworkConfig(respNetwork)
+	plan.Timeouts = configTimeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -231,17 +284,23 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("failed to delete network", err.Error())
+		addAPIError(&resp.Diagnostics, "failed to delete network", err)
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultNetworkDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// wait until network is deleted
-	waitForNetworkStatusCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	waitForNetworkStatusCtx, cancel := context.WithTimeout(ctx, deleteTimeout)
 	defer cancel()
 
-	_, err = resource_model.WaitUntilNetworkStatus(waitForNetworkStatusCtx, r.client, state.Id.ValueString(), dfcloud.NetworkStatusDeleted)
+	_, err = r.client.WaitForNetwork(waitForNetworkStatusCtx, state.Id.ValueString(), dfcloud.NetworkStatusDeleted)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to wait for network deletion", err.Error())
+		addAPIError(&resp.Diagnostics, "failed to wait for network deletion", err)
 		return
 	}
 }
@@ -249,11 +308,12 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 func (r *NetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	network, err := r.client.GetNetwork(ctx, req.ID)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to get network", err.Error())
+		addAPIError(&resp.Diagnostics, "failed to get network", err)
 		return
 	}
 
 	state := resource_model.FromNetworkConfig(network)
+	state.Timeouts = nullTimeouts(allTimeouts)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
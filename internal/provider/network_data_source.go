@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dragonflydb/terraform-provider-dfcloud/internal/resource_model"
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type NetworkDataSource struct {
+	client *dfcloud.Client
+}
+
+func NewNetworkDataSource() datasource.DataSource {
+	return &NetworkDataSource{}
+}
+
+func (d *NetworkDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "dfcloud_network"
+}
+
+func (d *NetworkDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := networkDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the network. Exactly one of `id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the network. Exactly one of `id` or `name` must be set. The name must match exactly one network.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing Dragonfly network by ID or name.",
+		Attributes:          attributes,
+	}
+}
+
+// networkDataSourceAttributes returns the computed attributes of a network
+// in data sources, matching the dfcloud_network resource.
+func networkDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the network.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the network.",
+			Computed:            true,
+		},
+		"created_at": schema.Int64Attribute{
+			MarkdownDescription: "The timestamp when the network was created.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The status of the network.",
+			Computed:            true,
+		},
+		"status_detail": schema.StringAttribute{
+			MarkdownDescription: "Additional details about the network status, such as why it failed to provision.",
+			Computed:            true,
+		},
+		"location": schema.SingleNestedAttribute{
+			MarkdownDescription: "The location configuration for the network.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"provider": schema.StringAttribute{
+					MarkdownDescription: "The provider for the network location.",
+					Computed:            true,
+				},
+				"region": schema.StringAttribute{
+					MarkdownDescription: "The region for the network location.",
+					Computed:            true,
+				},
+			},
+		},
+		"cidr_block": schema.StringAttribute{
+			MarkdownDescription: "The CIDR block for the network.",
+			Computed:            true,
+		},
+		"byoc_account_id": schema.StringAttribute{
+			MarkdownDescription: "The BYOC (Bring Your Own Cloud) account ID the network is provisioned into.",
+			Computed:            true,
+		},
+		"vpc": schema.SingleNestedAttribute{
+			MarkdownDescription: "The VPC information for the network. Null until the network has been provisioned.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"resource_id": schema.StringAttribute{
+					MarkdownDescription: "The resource ID of the VPC.",
+					Computed:            true,
+				},
+				"account_id": schema.StringAttribute{
+					MarkdownDescription: "The account ID of the VPC.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func (d *NetworkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dfcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError("failed to get provider", "failed to get provider")
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NetworkDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var id, name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() || id.IsUnknown() || name.IsUnknown() {
+		return
+	}
+
+	if id.IsNull() == name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Network Lookup",
+			"Exactly one of id or name must be set.",
+		)
+	}
+}
+
+func (d *NetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config resource_model.NetworkDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		network *dfcloud.Network
+		err     error
+	)
+	if !config.Id.IsNull() {
+		network, err = d.client.GetNetwork(ctx, config.Id.ValueString())
+		if errors.Is(err, dfcloud.ErrNotFound) {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "network not found", fmt.Sprintf("No network has ID %q.", config.Id.ValueString()))
+			return
+		}
+	} else {
+		network, err = d.findNetworkByName(ctx, config.Name.ValueString())
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "failed to read network", err)
+		return
+	}
+	if network == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "network not found", fmt.Sprintf("No network is named %q.", config.Name.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, resource_model.FromNetworkDataSourceConfig(network))...)
+}
+
+// findNetworkByName returns the network with the given name, or nil if there
+// is none. Networks being deleted are ignored, so the name of a deleted
+// network can be reused.
+func (d *NetworkDataSource) findNetworkByName(ctx context.Context, name string) (*dfcloud.Network, error) {
+	networks, err := d.client.ListNetworks(ctx, &dfcloud.ListNetworksOptions{NamePrefix: name})
+	if err != nil {
+		return nil, err
+	}
+
+	var found *dfcloud.Network
+	for _, n := range networks {
+		if n.NetworkConfig == nil || n.Name != name || n.Status == dfcloud.NetworkStatusDeleting || n.Status == dfcloud.NetworkStatusDeleted {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("multiple networks are named %q (%s and %s), look up the network by id instead", name, found.ID, n.ID)
+		}
+		found = n
+	}
+	return found, nil
+}
+
+var (
+	_ datasource.DataSourceWithConfigure      = &NetworkDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &NetworkDataSource{}
+)
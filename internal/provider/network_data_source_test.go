@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnit_NetworkDataSources(t *testing.T) {
+	_, providerConfig := testUnitFakeAPI(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccNetworkResourceConfig("tf-test") + `
+data "dfcloud_network" "by_name" {
+  name = dfcloud_network.test.name
+}
+
+data "dfcloud_networks" "test" {
+  name_regex = "^tf-"
+  region     = "us-east-1"
+
+  depends_on = [dfcloud_network.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.dfcloud_network.by_name", "id", "dfcloud_network.test", "id"),
+					resource.TestCheckResourceAttr("data.dfcloud_network.by_name", "cidr_block", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr("data.dfcloud_network.by_name", "status", "active"),
+					resource.TestCheckResourceAttrPair("data.dfcloud_network.by_name", "vpc.resource_id", "dfcloud_network.test", "vpc.resource_id"),
+					resource.TestCheckResourceAttrPair("data.dfcloud_network.by_name", "vpc.account_id", "dfcloud_network.test", "vpc.account_id"),
+					resource.TestCheckResourceAttr("data.dfcloud_networks.test", "networks.#", "1"),
+					resource.TestCheckResourceAttrPair("data.dfcloud_networks.test", "ids.0", "dfcloud_network.test", "id"),
+					resource.TestCheckResourceAttr("data.dfcloud_networks.test", "networks.0.location.provider", "aws"),
+				),
+			},
+		},
+	})
+}
+
+// testUnitCreateNetworks creates networks named after the keys of locations,
+// returning their IDs.
+func testUnitCreateNetworks(t *testing.T, p *testUnitProvider, locations map[string][2]string) map[string]string {
+	t.Helper()
+
+	ids := map[string]string{}
+	i := 0
+	for name, location := range locations {
+		state, err := p.apply("dfcloud_network", tftypes.Value{}, map[string]any{
+			"name": name,
+			"location": map[string]any{
+				"provider": location[0],
+				"region":   location[1],
+			},
+			"cidr_block": fmt.Sprintf("10.%d.0.0/16", i),
+		})
+		if err != nil {
+			t.Fatalf("create network %s error = %v", name, err)
+		}
+		ids[name] = testUnitAttr(t, state, "id")
+		i++
+	}
+	return ids
+}
+
+func TestUnit_NetworkDataSource_read(t *testing.T) {
+	p := newTestUnitProvider(t)
+	ids := testUnitCreateNetworks(t, p, map[string][2]string{
+		"tf-test":   {"aws", "us-east-1"},
+		"tf-test-2": {"aws", "us-east-1"},
+	})
+
+	for _, config := range []map[string]any{
+		{"id": ids["tf-test"]},
+		{"name": "tf-test"},
+	} {
+		state, err := p.readDataSource("dfcloud_network", config)
+		if err != nil {
+			t.Fatalf("read %v error = %v", config, err)
+		}
+		testUnitCheckAttrs(t, state, map[string]string{
+			"id":                ids["tf-test"],
+			"name":              "tf-test",
+			"status":            "active",
+			"location.provider": "aws",
+			"location.region":   "us-east-1",
+		})
+		if testUnitAttr(t, state, "vpc.resource_id") == "" {
+			t.Errorf("read %v: vpc.resource_id is not set", config)
+		}
+	}
+
+	for config, wantErr := range map[string]string{
+		"id":   `No network has ID "net_missing"`,
+		"name": `No network is named "net_missing"`,
+	} {
+		_, err := p.readDataSource("dfcloud_network", map[string]any{config: "net_missing"})
+		if err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("read by missing %s error = %v, want %q", config, err, wantErr)
+		}
+	}
+}
+
+func TestUnit_NetworksDataSource_read(t *testing.T) {
+	p := newTestUnitProvider(t)
+	ids := testUnitCreateNetworks(t, p, map[string][2]string{
+		"tf-test-a": {"aws", "us-east-1"},
+		"tf-test-b": {"aws", "eu-west-1"},
+		"tf-test-c": {"gcp", "us-central1"},
+		"other":     {"aws", "us-east-1"},
+	})
+	p.api.FailNetwork(ids["tf-test-b"], "quota exceeded")
+
+	tests := []struct {
+		config map[string]any
+		want   []string
+	}{
+		{
+			config: map[string]any{},
+			want:   []string{"other", "tf-test-a", "tf-test-b", "tf-test-c"},
+		},
+		{
+			config: map[string]any{"name_regex": "^tf-test-"},
+			want:   []string{"tf-test-a", "tf-test-b", "tf-test-c"},
+		},
+		{
+			config: map[string]any{"name_regex": "^tf-test-", "cloud_provider": "aws"},
+			want:   []string{"tf-test-a", "tf-test-b"},
+		},
+		{
+			config: map[string]any{"region": "us-east-1"},
+			want:   []string{"other", "tf-test-a"},
+		},
+		{
+			config: map[string]any{"status": "failed"},
+			want:   []string{"tf-test-b"},
+		},
+		{
+			config: map[string]any{"status": "active", "cloud_provider": "aws"},
+			want:   []string{"other", "tf-test-a"},
+		},
+	}
+	for _, tt := range tests {
+		state, err := p.readDataSource("dfcloud_networks", tt.config)
+		if err != nil {
+			t.Fatalf("read %v error = %v", tt.config, err)
+		}
+		checks := map[string]string{
+			"ids.#":      strconv.Itoa(len(tt.want)),
+			"networks.#": strconv.Itoa(len(tt.want)),
+		}
+		for i, name := range tt.want {
+			checks[fmt.Sprintf("ids.%d", i)] = ids[name]
+			checks[fmt.Sprintf("networks.%d.name", i)] = name
+		}
+		testUnitCheckAttrs(t, state, checks)
+	}
+
+	if _, err := p.readDataSource("dfcloud_networks", map[string]any{"name_regex": "("}); err == nil || !strings.Contains(err.Error(), "Invalid Regular Expression") {
+		t.Errorf("read with an invalid regex error = %v, want Invalid Regular Expression", err)
+	}
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
 	"testing"
 
 	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk/fake"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -120,3 +124,139 @@ resource "dfcloud_network" "test" {
 }
 `, name)
 }
+
+func testUnitCheckNetworkDestroy(api *fake.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "dfcloud_network" {
+				continue
+			}
+			if _, ok := api.Network(rs.Primary.ID); ok {
+				return fmt.Errorf("network %s still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func TestUnit_NetworkResource(t *testing.T) {
+	api, providerConfig := testUnitFakeAPI(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testUnitCheckNetworkDestroy(api),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccNetworkResourceConfig("tf-test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dfcloud_network.test", "name", "tf-test"),
+					resource.TestCheckResourceAttr("dfcloud_network.test", "status", "active"),
+					resource.TestCheckResourceAttr("dfcloud_network.test", "cidr_block", "10.0.0.0/16"),
+					resource.TestCheckResourceAttrSet("dfcloud_network.test", "id"),
+					resource.TestCheckResourceAttrSet("dfcloud_network.test", "vpc.resource_id"),
+					resource.TestCheckResourceAttrSet("dfcloud_network.test", "vpc.account_id"),
+				),
+			},
+			{
+				Config: providerConfig + testAccNetworkResourceConfig("tf-test-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dfcloud_network.test", "name", "tf-test-updated"),
+					resource.TestCheckResourceAttr("dfcloud_network.test", "status", "active"),
+				),
+			},
+			{
+				ResourceName:      "dfcloud_network.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnit_NetworkResource_apiError(t *testing.T) {
+	api, providerConfig := testUnitFakeAPI(t)
+	api.InjectFault(fake.Fault{
+		Method:     http.MethodPost,
+		Path:       "/v1/networks",
+		StatusCode: http.StatusBadRequest,
+		Message:    "invalid network",
+		Details: []dfcloud.ErrorDetail{
+			{Field: "cidr_block", Reason: "overlaps an existing network"},
+		},
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + testAccNetworkResourceConfig("tf-test"),
+				ExpectError: regexp.MustCompile(`overlaps an existing network`),
+			},
+		},
+	})
+}
+
+func testUnitNetworkConfig(name string) map[string]any {
+	return map[string]any{
+		"name": name,
+		"location": map[string]any{
+			"provider": "aws",
+			"region":   "us-east-1",
+		},
+		"cidr_block": "10.0.0.0/16",
+	}
+}
+
+func TestUnit_NetworkResource_lifecycle(t *testing.T) {
+	p := newTestUnitProvider(t)
+
+	state, err := p.apply("dfcloud_network", tftypes.Value{}, testUnitNetworkConfig("tf-test"))
+	if err != nil {
+		t.Fatalf("create error = %v", err)
+	}
+	id := testUnitAttr(t, state, "id")
+	testUnitCheckAttrs(t, state, map[string]string{
+		"name":       "tf-test",
+		"status":     "active",
+		"cidr_block": "10.0.0.0/16",
+	})
+	if testUnitAttr(t, state, "vpc.resource_id") == "" {
+		t.Errorf("vpc.resource_id is not set")
+	}
+
+	state, err = p.apply("dfcloud_network", state, testUnitNetworkConfig("tf-test-updated"))
+	if err != nil {
+		t.Fatalf("update error = %v", err)
+	}
+	testUnitCheckAttrs(t, state, map[string]string{
+		"id":   id,
+		"name": "tf-test-updated",
+	})
+	if network, _ := p.api.Network(id); network.Name != "tf-test-updated" {
+		t.Errorf("API network name = %q, want %q", network.Name, "tf-test-updated")
+	}
+
+	imported, err := p.importState("dfcloud_network", id)
+	if err != nil {
+		t.Fatalf("import error = %v", err)
+	}
+	testUnitCheckAttrs(t, imported, map[string]string{
+		"name":       "tf-test-updated",
+		"cidr_block": "10.0.0.0/16",
+	})
+
+	if err := p.destroy("dfcloud_network", state); err != nil {
+		t.Fatalf("destroy error = %v", err)
+	}
+	if _, ok := p.api.Network(id); ok {
+		t.Fatalf("network %s still exists", id)
+	}
+
+	state, err = p.refresh("dfcloud_network", state)
+	if err != nil {
+		t.Fatalf("refresh error = %v", err)
+	}
+	if !state.IsNull() {
+		t.Fatalf("refresh state = %v, want the deleted network removed", state)
+	}
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/dragonflydb/terraform-provider-dfcloud/internal/resource_model"
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type NetworksDataSource struct {
+	client *dfcloud.Client
+}
+
+func NewNetworksDataSource() datasource.DataSource {
+	return &NetworksDataSource{}
+}
+
+func (d *NetworksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "dfcloud_networks"
+}
+
+func (d *NetworksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Dragonfly networks, ordered by name and then ID.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only include networks whose name matches the [regular expression](https://github.com/google/re2/wiki/Syntax).",
+				Optional:            true,
+			},
+			"cloud_provider": schema.StringAttribute{
+				MarkdownDescription: "Only include networks in the given cloud provider, such as `aws`.",
+				Optional:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Only include networks in the given region.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only include networks with the given status.",
+				Optional:            true,
+			},
+			"byoc_account_id": schema.StringAttribute{
+				MarkdownDescription: "Only include networks provisioned into the given BYOC account.",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matching networks, in the same order as `networks`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"networks": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching networks, ordered by name and then ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: networkDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *NetworksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dfcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError("failed to get provider", "failed to get provider")
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NetworksDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var nameRegex types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+	if resp.Diagnostics.HasError() || nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Regular Expression",
+			fmt.Sprintf("name_regex is not a valid regular expression: %s.", err),
+		)
+	}
+}
+
+func (d *NetworksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state resource_model.Networks
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+	}
+
+	networks, err := d.client.ListNetworks(ctx, resource_model.IntoListNetworksOptions(state))
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "failed to list networks", err)
+		return
+	}
+
+	networks = slices.DeleteFunc(networks, func(n *dfcloud.Network) bool {
+		return n.NetworkConfig == nil || (nameRegex != nil && !nameRegex.MatchString(n.Name))
+	})
+	// Sort so adding a network doesn't reorder the others in the plan.
+	slices.SortStableFunc(networks, func(a, b *dfcloud.Network) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.ID, b.ID))
+	})
+
+	state.IDs = make([]types.String, 0, len(networks))
+	state.Networks = make([]*resource_model.NetworkDataSource, 0, len(networks))
+	for _, n := range networks {
+		model := resource_model.FromNetworkDataSourceConfig(n)
+		state.IDs = append(state.IDs, model.Id)
+		state.Networks = append(state.Networks, model)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+var (
+	_ datasource.DataSourceWithConfigure      = &NetworksDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &NetworksDataSource{}
+)
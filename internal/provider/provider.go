@@ -2,9 +2,12 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,8 +19,14 @@ type DragonflyDBCloudProvider struct {
 }
 
 type ProviderSchema struct {
-	ApiKey  types.String `tfsdk:"api_key"`
-	ApiHost types.String `tfsdk:"api_host"`
+	ApiKey     types.String `tfsdk:"api_key"`
+	ApiHost    types.String `tfsdk:"api_host"`
+	MaxRetries types.Int64  `tfsdk:"max_retries"`
+	MaxBackoff types.String `tfsdk:"max_backoff"`
+
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 }
 
 func NewDragonflyDBCloudProvider(version string) func() provider.Provider {
@@ -43,7 +52,27 @@ func (p DragonflyDBCloudProvider) Schema(ctx context.Context, req provider.Schem
 			},
 			"api_host": schema.StringAttribute{
 				Optional:    true,
-				Description: "The URL of the Dragonfly Cloud API.",
+				Description: "The URL of the Dragonfly Cloud API. Either a hostname, which is accessed over HTTPS, or a base URL with a scheme and optional path prefix, such as `http://localhost:8080`. Defaults to `api.dragonflydb.cloud`.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Maximum number of times a throttled or failed API request is retried. Set to 0 to disable retries. Defaults to %d.", dfcloud.DefaultRetryPolicy.MaxRetries),
+			},
+			"max_backoff": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Maximum delay between API request retries, as a duration string such as `10s` or `1m`. Defaults to `%s`.", dfcloud.DefaultRetryPolicy.MaxBackoff),
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM encoded CA certificate bundle to trust when connecting to the Dragonfly Cloud API, in addition to the system certificates.",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of the proxy to connect to the Dragonfly Cloud API through, such as `http://proxy.internal:3128`. Defaults to the HTTPS_PROXY environment variable.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip verifying the Dragonfly Cloud API TLS certificate. This should only be used for testing.",
 			},
 		},
 		Description: `The Dragonfly Cloud provider is used to interact with resources supported by Dragonfly Cloud.
@@ -70,18 +99,60 @@ func (p DragonflyDBCloudProvider) Configure(ctx context.Context, req provider.Co
 		options = append(options, dfcloud.WithAPIHost(config.ApiHost.ValueString()))
 	}
 
+	retryPolicy := dfcloud.DefaultRetryPolicy
+	if !config.MaxRetries.IsNull() {
+		if config.MaxRetries.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("max_retries"), "invalid max_retries", "max_retries must not be negative")
+			return
+		}
+		retryPolicy.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if config.MaxBackoff.ValueString() != "" {
+		maxBackoff, err := time.ParseDuration(config.MaxBackoff.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("max_backoff"), "invalid max_backoff", err.Error())
+			return
+		}
+		retryPolicy.MaxBackoff = maxBackoff
+		if retryPolicy.MinBackoff > maxBackoff {
+			retryPolicy.MinBackoff = maxBackoff
+		}
+	}
+	options = append(options, dfcloud.WithRetryPolicy(retryPolicy))
+
+	if config.CACertFile.ValueString() != "" {
+		options = append(options, dfcloud.WithCACertFile(config.CACertFile.ValueString()))
+	}
+	if config.ProxyURL.ValueString() != "" {
+		options = append(options, dfcloud.WithProxyURL(config.ProxyURL.ValueString()))
+	}
+	if config.InsecureSkipVerify.ValueBool() {
+		options = append(options, dfcloud.WithInsecureSkipVerify(true))
+	}
+
+	options = append(options, requestLoggingOptions()...)
+	options = append(options, dfcloud.WithWaitLogger(logWaitProgress))
+
 	client, err := dfcloud.NewClient(options...)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create client", err.Error())
 		return
 	}
 
+	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
 func (p DragonflyDBCloudProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		// Provider specific implementation
+		NewBackupsDataSource,
+		NewCatalogDataSource,
+		NewDatastoreDataSource,
+		NewDatastoresDataSource,
+		NewNetworkDataSource,
+		NewNetworksDataSource,
+		NewConnectionDataSource,
+		NewConnectionsDataSource,
 	}
 }
 
@@ -90,6 +161,7 @@ func (p DragonflyDBCloudProvider) Resources(ctx context.Context) []func() resour
 		NewDatastoreResource,
 		NewNetworkResource,
 		NewConnectionResource,
+		NewBackupResource,
 	}
 }
 
@@ -1,12 +1,24 @@
 package provider
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
 	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk/fake"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
@@ -34,3 +46,489 @@ func testClient() *dfcloud.Client {
 
 	return tc
 }
+
+// testUnitFakeAPI starts a fake API for unit tests, which run the provider
+// against it without TF_ACC or an API key. It returns the fake and the
+// provider configuration to prepend to test configs.
+//
+// Unit tests run the terraform CLI. They are skipped in short mode, or when
+// terraform isn't on the PATH and neither TF_ACC_TERRAFORM_PATH nor
+// TF_ACC_TERRAFORM_VERSION requests one, so a plain go test still passes
+// offline. When a version is requested, it is installed if needed and the
+// tests fail if that isn't possible. See [newTestUnitProvider] for tests
+// that don't need the CLI.
+func testUnitFakeAPI(t *testing.T) (*fake.Server, string) {
+	t.Helper()
+
+	if testing.Short() {
+		t.Skip("skipping terraform CLI test in short mode")
+	}
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") == "" && os.Getenv("TF_ACC_TERRAFORM_VERSION") == "" {
+		if _, err := exec.LookPath("terraform"); err != nil {
+			t.Skip("skipping terraform CLI test, terraform isn't on the PATH and TF_ACC_TERRAFORM_PATH or TF_ACC_TERRAFORM_VERSION isn't set")
+		}
+	}
+
+	api := fake.NewServer()
+	srv := httptest.NewServer(api)
+	t.Cleanup(srv.Close)
+
+	config := fmt.Sprintf(`
+provider "dfcloud" {
+  api_key  = "test-key"
+  api_host = %q
+}
+`, srv.URL)
+	return api, config
+}
+
+// testUnitProvider serves the provider in-process against a fake API and
+// drives it through the provider protocol the way terraform does, so tests
+// can run resources and data sources without the terraform CLI.
+type testUnitProvider struct {
+	t   *testing.T
+	api *fake.Server
+	// client calls the fake API directly, to change resources outside
+	// Terraform.
+	client *dfcloud.Client
+	server tfprotov6.ProviderServer
+	schema *tfprotov6.GetProviderSchemaResponse
+}
+
+// newTestUnitProvider starts a fake API with the given options and returns
+// the provider configured to use it.
+func newTestUnitProvider(t *testing.T, opts ...fake.Option) *testUnitProvider {
+	t.Helper()
+
+	api := fake.NewServer(opts...)
+	srv := httptest.NewServer(api)
+	t.Cleanup(srv.Close)
+
+	ctx := context.Background()
+	server := providerserver.NewProtocol6(NewDragonflyDBCloudProvider("test")())()
+	schema, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema() error = %v", err)
+	}
+	if err := testUnitDiagnosticsError(schema.Diagnostics); err != nil {
+		t.Fatalf("GetProviderSchema() error = %v", err)
+	}
+
+	client, err := dfcloud.NewClient(dfcloud.WithAPIKey("test-key"), dfcloud.WithAPIHost(srv.URL))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	p := &testUnitProvider{t: t, api: api, client: client, server: server, schema: schema}
+	typ := schema.Provider.ValueType()
+	config := p.dynamicValue(typ, testUnitValue(t, typ, map[string]any{
+		"api_key":  "test-key",
+		"api_host": srv.URL,
+	}))
+	resp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: &config,
+	})
+	if err != nil {
+		t.Fatalf("ConfigureProvider() error = %v", err)
+	}
+	if err := testUnitDiagnosticsError(resp.Diagnostics); err != nil {
+		t.Fatalf("ConfigureProvider() error = %v", err)
+	}
+	return p
+}
+
+// readDataSource reads the data source with the given config, returning its
+// state.
+func (p *testUnitProvider) readDataSource(typeName string, config map[string]any) (tftypes.Value, error) {
+	p.t.Helper()
+
+	ctx := context.Background()
+	schema, ok := p.schema.DataSourceSchemas[typeName]
+	if !ok {
+		p.t.Fatalf("unknown data source %s", typeName)
+	}
+	typ := schema.ValueType()
+	configValue := p.dynamicValue(typ, testUnitValue(p.t, typ, config))
+
+	validateResp, err := p.server.ValidateDataResourceConfig(ctx, &tfprotov6.ValidateDataResourceConfigRequest{
+		TypeName: typeName,
+		Config:   &configValue,
+	})
+	if err != nil {
+		p.t.Fatalf("ValidateDataResourceConfig() error = %v", err)
+	}
+	if err := testUnitDiagnosticsError(validateResp.Diagnostics); err != nil {
+		return tftypes.NewValue(typ, nil), err
+	}
+
+	resp, err := p.server.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{
+		TypeName: typeName,
+		Config:   &configValue,
+	})
+	if err != nil {
+		p.t.Fatalf("ReadDataSource() error = %v", err)
+	}
+	return p.value(typ, resp.State), testUnitDiagnosticsError(resp.Diagnostics)
+}
+
+// apply plans and applies the resource with the given config, creating it
+// if prior is the zero or a null value and updating it otherwise. It returns the new state,
+// which may be set even if applying fails. The test fails if the plan
+// replaces the resource.
+func (p *testUnitProvider) apply(typeName string, prior tftypes.Value, config map[string]any) (tftypes.Value, error) {
+	p.t.Helper()
+
+	ctx := context.Background()
+	schema := p.resourceSchema(typeName)
+	typ := schema.ValueType()
+	if prior.Type() == nil || prior.IsNull() {
+		prior = tftypes.NewValue(typ, nil)
+	}
+	configValue := testUnitValue(p.t, typ, config)
+	configDynamic := p.dynamicValue(typ, configValue)
+	priorDynamic := p.dynamicValue(typ, prior)
+
+	validateResp, err := p.server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+		TypeName: typeName,
+		Config:   &configDynamic,
+	})
+	if err != nil {
+		p.t.Fatalf("ValidateResourceConfig() error = %v", err)
+	}
+	if err := testUnitDiagnosticsError(validateResp.Diagnostics); err != nil {
+		return prior, err
+	}
+
+	proposed := p.dynamicValue(typ, testUnitProposedNew(schema.Block, prior, configValue))
+	planResp, err := p.server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       &priorDynamic,
+		ProposedNewState: &proposed,
+		Config:           &configDynamic,
+	})
+	if err != nil {
+		p.t.Fatalf("PlanResourceChange() error = %v", err)
+	}
+	if err := testUnitDiagnosticsError(planResp.Diagnostics); err != nil {
+		return prior, err
+	}
+	if !prior.IsNull() && len(planResp.RequiresReplace) > 0 {
+		p.t.Fatalf("plan replaces %s: %v", typeName, planResp.RequiresReplace)
+	}
+
+	applyResp, err := p.server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:       typeName,
+		PriorState:     &priorDynamic,
+		PlannedState:   planResp.PlannedState,
+		Config:         &configDynamic,
+		PlannedPrivate: planResp.PlannedPrivate,
+	})
+	if err != nil {
+		p.t.Fatalf("ApplyResourceChange() error = %v", err)
+	}
+	return p.value(typ, applyResp.NewState), testUnitDiagnosticsError(applyResp.Diagnostics)
+}
+
+// refresh reads the resource, returning its new state, which is null if the
+// resource no longer exists.
+func (p *testUnitProvider) refresh(typeName string, state tftypes.Value) (tftypes.Value, error) {
+	p.t.Helper()
+
+	typ := p.resourceSchema(typeName).ValueType()
+	current := p.dynamicValue(typ, state)
+	resp, err := p.server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName:     typeName,
+		CurrentState: &current,
+	})
+	if err != nil {
+		p.t.Fatalf("ReadResource() error = %v", err)
+	}
+	return p.value(typ, resp.NewState), testUnitDiagnosticsError(resp.Diagnostics)
+}
+
+// destroy plans and applies deleting the resource.
+func (p *testUnitProvider) destroy(typeName string, state tftypes.Value) error {
+	p.t.Helper()
+
+	ctx := context.Background()
+	typ := p.resourceSchema(typeName).ValueType()
+	prior := p.dynamicValue(typ, state)
+	null := p.dynamicValue(typ, tftypes.NewValue(typ, nil))
+
+	planResp, err := p.server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       &prior,
+		ProposedNewState: &null,
+		Config:           &null,
+	})
+	if err != nil {
+		p.t.Fatalf("PlanResourceChange() error = %v", err)
+	}
+	if err := testUnitDiagnosticsError(planResp.Diagnostics); err != nil {
+		return err
+	}
+
+	applyResp, err := p.server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     typeName,
+		PriorState:   &prior,
+		PlannedState: planResp.PlannedState,
+		Config:       &null,
+	})
+	if err != nil {
+		p.t.Fatalf("ApplyResourceChange() error = %v", err)
+	}
+	return testUnitDiagnosticsError(applyResp.Diagnostics)
+}
+
+// importState imports the resource with the given ID and refreshes it, as
+// terraform import does.
+func (p *testUnitProvider) importState(typeName string, id string) (tftypes.Value, error) {
+	p.t.Helper()
+
+	typ := p.resourceSchema(typeName).ValueType()
+	resp, err := p.server.ImportResourceState(context.Background(), &tfprotov6.ImportResourceStateRequest{
+		TypeName: typeName,
+		ID:       id,
+	})
+	if err != nil {
+		p.t.Fatalf("ImportResourceState() error = %v", err)
+	}
+	if err := testUnitDiagnosticsError(resp.Diagnostics); err != nil {
+		return tftypes.NewValue(typ, nil), err
+	}
+	if len(resp.ImportedResources) != 1 {
+		p.t.Fatalf("imported %d resources, want 1", len(resp.ImportedResources))
+	}
+	return p.refresh(typeName, p.value(typ, resp.ImportedResources[0].State))
+}
+
+func (p *testUnitProvider) resourceSchema(typeName string) *tfprotov6.Schema {
+	p.t.Helper()
+
+	schema, ok := p.schema.ResourceSchemas[typeName]
+	if !ok {
+		p.t.Fatalf("unknown resource %s", typeName)
+	}
+	return schema
+}
+
+func (p *testUnitProvider) dynamicValue(typ tftypes.Type, v tftypes.Value) tfprotov6.DynamicValue {
+	p.t.Helper()
+
+	dv, err := tfprotov6.NewDynamicValue(typ, v)
+	if err != nil {
+		p.t.Fatalf("NewDynamicValue() error = %v", err)
+	}
+	return dv
+}
+
+func (p *testUnitProvider) value(typ tftypes.Type, dv *tfprotov6.DynamicValue) tftypes.Value {
+	p.t.Helper()
+
+	if dv == nil {
+		return tftypes.NewValue(typ, nil)
+	}
+	v, err := dv.Unmarshal(typ)
+	if err != nil {
+		p.t.Fatalf("Unmarshal() error = %v", err)
+	}
+	return v
+}
+
+// testUnitValue converts v into a value of the given type. Objects are
+// given as map[string]any, with missing attributes set to null, and lists
+// and sets as []any.
+func testUnitValue(t *testing.T, typ tftypes.Type, v any) tftypes.Value {
+	t.Helper()
+
+	if v == nil {
+		return tftypes.NewValue(typ, nil)
+	}
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		attrs, ok := v.(map[string]any)
+		if !ok {
+			t.Fatalf("got %T for object, want map[string]any", v)
+		}
+		for name := range attrs {
+			if _, ok := typ.AttributeTypes[name]; !ok {
+				t.Fatalf("unknown attribute %q", name)
+			}
+		}
+		values := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+		for name, attrType := range typ.AttributeTypes {
+			values[name] = testUnitValue(t, attrType, attrs[name])
+		}
+		return tftypes.NewValue(typ, values)
+	case tftypes.List:
+		return tftypes.NewValue(typ, testUnitElements(t, typ.ElementType, v))
+	case tftypes.Set:
+		return tftypes.NewValue(typ, testUnitElements(t, typ.ElementType, v))
+	default:
+		return tftypes.NewValue(typ, v)
+	}
+}
+
+func testUnitElements(t *testing.T, typ tftypes.Type, v any) []tftypes.Value {
+	t.Helper()
+
+	elems, ok := v.([]any)
+	if !ok {
+		t.Fatalf("got %T for collection, want []any", v)
+	}
+	values := make([]tftypes.Value, 0, len(elems))
+	for _, elem := range elems {
+		values = append(values, testUnitValue(t, typ, elem))
+	}
+	return values
+}
+
+// testUnitProposedNew returns the new state terraform proposes from the
+// prior state and config: the config, with computed attributes the config
+// doesn't set kept from the prior state.
+func testUnitProposedNew(block *tfprotov6.SchemaBlock, prior, config tftypes.Value) tftypes.Value {
+	if prior.IsNull() || config.IsNull() {
+		return config
+	}
+
+	var priorAttrs, configAttrs map[string]tftypes.Value
+	if err := prior.As(&priorAttrs); err != nil {
+		panic(err)
+	}
+	if err := config.As(&configAttrs); err != nil {
+		panic(err)
+	}
+	values := make(map[string]tftypes.Value, len(configAttrs))
+	for name, v := range configAttrs {
+		values[name] = v
+	}
+	for _, attr := range block.Attributes {
+		values[attr.Name] = testUnitProposedNewAttribute(attr, priorAttrs[attr.Name], configAttrs[attr.Name])
+	}
+	return tftypes.NewValue(config.Type(), values)
+}
+
+func testUnitProposedNewAttribute(attr *tfprotov6.SchemaAttribute, prior, config tftypes.Value) tftypes.Value {
+	if attr.Computed && config.IsNull() {
+		return prior
+	}
+	if attr.NestedType == nil || attr.NestedType.Nesting != tfprotov6.SchemaObjectNestingModeSingle ||
+		prior.IsNull() || !prior.IsKnown() || !config.IsKnown() {
+		return config
+	}
+	return testUnitProposedNew(&tfprotov6.SchemaBlock{Attributes: attr.NestedType.Attributes}, prior, config)
+}
+
+// testUnitAttr returns the attribute of v at path, formatted like the state
+// checks of resource.TestCheckResourceAttr: path elements are separated by
+// dots, list elements are selected by index, and "#" is the number of
+// elements. Null values are returned as "".
+func testUnitAttr(t *testing.T, v tftypes.Value, path string) string {
+	t.Helper()
+
+	for _, step := range strings.Split(path, ".") {
+		if v.IsNull() {
+			t.Fatalf("%s: %s is null", path, step)
+		}
+		switch {
+		case v.Type().Is(tftypes.Object{}):
+			var attrs map[string]tftypes.Value
+			if err := v.As(&attrs); err != nil {
+				t.Fatalf("%s: %v", path, err)
+			}
+			attr, ok := attrs[step]
+			if !ok {
+				t.Fatalf("%s: unknown attribute %q", path, step)
+			}
+			v = attr
+		case v.Type().Is(tftypes.List{}) || v.Type().Is(tftypes.Set{}):
+			var elems []tftypes.Value
+			if err := v.As(&elems); err != nil {
+				t.Fatalf("%s: %v", path, err)
+			}
+			if step == "#" {
+				return strconv.Itoa(len(elems))
+			}
+			i, err := strconv.Atoi(step)
+			if err != nil || i < 0 || i >= len(elems) {
+				t.Fatalf("%s: no element %s of %d", path, step, len(elems))
+			}
+			v = elems[i]
+		default:
+			t.Fatalf("%s: can't select %q from %s", path, step, v.Type())
+		}
+	}
+
+	switch {
+	case !v.IsKnown():
+		return "<unknown>"
+	case v.IsNull():
+		return ""
+	case v.Type().Is(tftypes.String):
+		var s string
+		_ = v.As(&s)
+		return s
+	case v.Type().Is(tftypes.Number):
+		var n big.Float
+		_ = v.As(&n)
+		return n.Text('f', -1)
+	case v.Type().Is(tftypes.Bool):
+		var b bool
+		_ = v.As(&b)
+		return strconv.FormatBool(b)
+	}
+	t.Fatalf("%s: %s is not a primitive", path, v.Type())
+	return ""
+}
+
+// testUnitCheckAttrs checks the attributes of v at each path, as formatted
+// by testUnitAttr.
+func testUnitCheckAttrs(t *testing.T, v tftypes.Value, want map[string]string) {
+	t.Helper()
+
+	for path, want := range want {
+		if got := testUnitAttr(t, v, path); got != want {
+			t.Errorf("%s = %q, want %q", path, got, want)
+		}
+	}
+}
+
+// testUnitDiagnosticsError returns the error diagnostics as an error, or nil
+// if there are none.
+func testUnitDiagnosticsError(diags []*tfprotov6.Diagnostic) error {
+	var errs []error
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			errs = append(errs, fmt.Errorf("%s: %s", d.Summary, d.Detail))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// testUnitClock is a clock for the fake API that moves forward by step each
+// time it is read, so resources finish transitioning after a predictable
+// number of requests.
+type testUnitClock struct {
+	mu   sync.Mutex
+	now  time.Time
+	step time.Duration
+}
+
+func newTestUnitClock() *testUnitClock {
+	return &testUnitClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *testUnitClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(c.step)
+	return c.now
+}
+
+func (c *testUnitClock) setStep(step time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.step = step
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// allTimeouts are the operations of a timeouts block built with
+// [timeouts.BlockAll].
+var allTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Update: true,
+	Delete: true,
+}
+
+// nullTimeouts returns an unset timeouts block with the given operations,
+// for building state that isn't based on configuration, such as on import.
+func nullTimeouts(opts timeouts.Opts) timeouts.Value {
+	attrTypes := map[string]attr.Type{}
+	for name, ok := range map[string]bool{
+		"create": opts.Create,
+		"read":   opts.Read,
+		"update": opts.Update,
+		"delete": opts.Delete,
+	} {
+		if ok {
+			attrTypes[name] = types.StringType
+		}
+	}
+	return timeouts.Value{
+		Object: types.ObjectNull(attrTypes),
+	}
+}
@@ -0,0 +1,57 @@
+package resource_model
+
+import (
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Backup struct {
+	ID          types.String `tfsdk:"id"`
+	DatastoreID types.String `tfsdk:"datastore_id"`
+	Type        types.String `tfsdk:"type"`
+	Status      types.String `tfsdk:"status"`
+	SizeBytes   types.Int64  `tfsdk:"size_bytes"`
+	CreatedAt   types.Int64  `tfsdk:"created_at"`
+	ExpiresAt   types.Int64  `tfsdk:"expires_at"`
+}
+
+// BackupResource maps the dfcloud_backup resource schema data.
+type BackupResource struct {
+	Backup
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func FromBackup(in *dfcloud.Backup) *Backup {
+	return &Backup{
+		ID:          types.StringValue(in.ID),
+		DatastoreID: types.StringValue(in.DatastoreID),
+		Type:        types.StringValue(string(in.Type)),
+		Status:      types.StringValue(string(in.Status)),
+		SizeBytes:   types.Int64Value(in.SizeBytes),
+		CreatedAt:   types.Int64Value(in.CreatedAt),
+		ExpiresAt:   types.Int64Value(in.ExpiresAt),
+	}
+}
+
+// Backups is the model of the dfcloud_backups data source.
+type Backups struct {
+	DatastoreID   types.String `tfsdk:"datastore_id"`
+	Type          types.String `tfsdk:"type"`
+	Status        types.String `tfsdk:"status"`
+	CreatedAfter  types.Int64  `tfsdk:"created_after"`
+	CreatedBefore types.Int64  `tfsdk:"created_before"`
+	MostRecent    types.Bool   `tfsdk:"most_recent"`
+	Backups       []*Backup    `tfsdk:"backups"`
+}
+
+// IntoListBackupsOptions converts the data source filters into list options.
+func IntoListBackupsOptions(in Backups) *dfcloud.ListBackupsOptions {
+	return &dfcloud.ListBackupsOptions{
+		DatastoreID:   in.DatastoreID.ValueString(),
+		Type:          dfcloud.BackupType(in.Type.ValueString()),
+		Status:        dfcloud.BackupStatus(in.Status.ValueString()),
+		CreatedAfter:  in.CreatedAfter.ValueInt64(),
+		CreatedBefore: in.CreatedBefore.ValueInt64(),
+	}
+}
@@ -0,0 +1,66 @@
+package resource_model
+
+import (
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Catalog is the model of the dfcloud_catalog data source.
+type Catalog struct {
+	CloudProvider types.String       `tfsdk:"cloud_provider"`
+	Source        types.String       `tfsdk:"source"`
+	Providers     []*CatalogProvider `tfsdk:"providers"`
+}
+
+type CatalogProvider struct {
+	Provider         types.String     `tfsdk:"provider"`
+	Regions          []*CatalogRegion `tfsdk:"regions"`
+	PerformanceTiers []*CatalogTier   `tfsdk:"performance_tiers"`
+}
+
+type CatalogRegion struct {
+	Region            types.String   `tfsdk:"region"`
+	AvailabilityZones []types.String `tfsdk:"availability_zones"`
+}
+
+type CatalogTier struct {
+	PerformanceTier  types.String  `tfsdk:"performance_tier"`
+	MaxMemoryBytes   []types.Int64 `tfsdk:"max_memory_bytes"`
+	ShardMemoryBytes []types.Int64 `tfsdk:"shard_memory_bytes"`
+}
+
+// FromCatalogProvider converts a provider of the catalog into the data
+// source model.
+func FromCatalogProvider(in dfcloud.CatalogProvider) *CatalogProvider {
+	p := &CatalogProvider{
+		Provider:         types.StringValue(string(in.Provider)),
+		Regions:          make([]*CatalogRegion, 0, len(in.Regions)),
+		PerformanceTiers: make([]*CatalogTier, 0, len(in.PerformanceTiers)),
+	}
+	for _, region := range in.Regions {
+		r := &CatalogRegion{
+			Region:            types.StringValue(region.Region),
+			AvailabilityZones: make([]types.String, 0, len(region.AvailabilityZones)),
+		}
+		for _, zone := range region.AvailabilityZones {
+			r.AvailabilityZones = append(r.AvailabilityZones, types.StringValue(zone))
+		}
+		p.Regions = append(p.Regions, r)
+	}
+	for _, tier := range in.PerformanceTiers {
+		p.PerformanceTiers = append(p.PerformanceTiers, &CatalogTier{
+			PerformanceTier:  types.StringValue(string(tier.PerformanceTier)),
+			MaxMemoryBytes:   int64Values(tier.MaxMemoryBytes),
+			ShardMemoryBytes: int64Values(tier.ShardMemoryBytes),
+		})
+	}
+	return p
+}
+
+func int64Values(in []uint64) []types.Int64 {
+	out := make([]types.Int64, 0, len(in))
+	for _, v := range in {
+		out = append(out, types.Int64Value(int64(v)))
+	}
+	return out
+}
@@ -1,12 +1,8 @@
 package resource_model
 
 import (
-	"context"
-	"errors"
-	"fmt"
-	"time"
-
 	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -18,6 +14,7 @@ type Connection struct {
 	Status       types.String     `tfsdk:"status"`
 	StatusDetail types.String     `tfsdk:"status_detail"`
 	PeerConnID   types.String     `tfsdk:"peer_connection_id"`
+	Timeouts     timeouts.Value   `tfsdk:"timeouts"`
 }
 
 type PeerConfigModel struct {
@@ -85,39 +82,45 @@ func FromConnectionConfig(in *dfcloud.Connection) *Connection {
 	}
 }
 
-func WaitUntilConnectionStatus(ctx context.Context, client *dfcloud.Client, id string, status dfcloud.ConnectionStatus) (*dfcloud.Connection, error) {
-	if id == "" {
-		return nil, fmt.Errorf("missing connection id")
-	}
-	for {
-		conn, err := client.GetConnection(ctx, id)
-		if errors.Is(err, dfcloud.ErrNotFound) {
-			if status == dfcloud.ConnectionStatusDeleted {
-				return &dfcloud.Connection{
-					ID:     id,
-					Status: dfcloud.ConnectionStatusDeleted,
-				}, nil
-			}
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-time.After(5 * time.Second):
-				continue
-			}
-		}
-		if err != nil {
-			return nil, err
-		}
+// ConnectionDataSource maps the dfcloud_connection data source schema data.
+type ConnectionDataSource struct {
+	ConnectionID types.String     `tfsdk:"connection_id"`
+	Name         types.String     `tfsdk:"name"`
+	NetworkID    types.String     `tfsdk:"network_id"`
+	Peer         *PeerConfigModel `tfsdk:"peer"`
+	Status       types.String     `tfsdk:"status"`
+	StatusDetail types.String     `tfsdk:"status_detail"`
+	PeerConnID   types.String     `tfsdk:"peer_connection_id"`
+}
 
-		if conn.Status == status {
-			return conn, nil
-		}
+func FromConnectionDataSourceConfig(in *dfcloud.Connection) *ConnectionDataSource {
+	conn := FromConnectionConfig(in)
+	return &ConnectionDataSource{
+		ConnectionID: conn.ConnectionID,
+		Name:         conn.Name,
+		NetworkID:    conn.NetworkID,
+		Peer:         conn.Peer,
+		Status:       conn.Status,
+		StatusDetail: conn.StatusDetail,
+		PeerConnID:   conn.PeerConnID,
+	}
+}
 
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-time.After(5 * time.Second):
+// Connections is the model of the dfcloud_connections data source.
+type Connections struct {
+	NameRegex   types.String            `tfsdk:"name_regex"`
+	NetworkID   types.String            `tfsdk:"network_id"`
+	Status      types.String            `tfsdk:"status"`
+	IDs         []types.String          `tfsdk:"ids"`
+	Connections []*ConnectionDataSource `tfsdk:"connections"`
+}
 
-		}
+// IntoListConnectionsOptions converts the data source filters into list
+// options. The name regex is matched by the data source, since the API only
+// filters by name prefix.
+func IntoListConnectionsOptions(in Connections) *dfcloud.ListConnectionsOptions {
+	return &dfcloud.ListConnectionsOptions{
+		NetworkID: in.NetworkID.ValueString(),
+		Status:    dfcloud.ConnectionStatus(in.Status.ValueString()),
 	}
 }
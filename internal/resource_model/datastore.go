@@ -2,13 +2,12 @@ package resource_model
 
 import (
 	"context"
-	"errors"
-	"fmt"
-	"time"
 
 	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/samber/lo"
 )
 
@@ -26,7 +25,35 @@ type Datastore struct {
 	Addr              types.String      `tfsdk:"addr"`
 	DisablePassKey    types.Bool        `tfsdk:"disable_pass_key"`
 	MaintenanceWindow types.Object      `tfsdk:"maintenance_window"`
+	BackupPolicy      types.Object      `tfsdk:"backup_policy"`
 	BYOCAccountID     types.String      `tfsdk:"byoc_account_id"`
+	Status            types.String      `tfsdk:"status"`
+	StatusDetail      types.String      `tfsdk:"status_detail"`
+	DashboardURL      types.String      `tfsdk:"dashboard_url"`
+	Timeouts          timeouts.Value    `tfsdk:"timeouts"`
+
+	// RestoreFromBackupID is only sent to the API, since the API forgets
+	// the backup once it has been restored, so FromConfig leaves it as is.
+	RestoreFromBackupID types.String `tfsdk:"restore_from_backup_id"`
+}
+
+// DatastoreBackupPolicy maps the backup_policy attribute.
+type DatastoreBackupPolicy struct {
+	Enabled   types.Bool  `tfsdk:"enabled"`
+	Retention types.Int64 `tfsdk:"retention"`
+	EveryHour types.Bool  `tfsdk:"every_hour"`
+	EveryDay  types.Bool  `tfsdk:"every_day"`
+	Hours     types.List  `tfsdk:"hours"`
+	WeekDays  types.List  `tfsdk:"weekdays"`
+}
+
+var backupPolicyAttrTypes = map[string]attr.Type{
+	"enabled":    types.BoolType,
+	"retention":  types.Int64Type,
+	"every_hour": types.BoolType,
+	"every_day":  types.BoolType,
+	"hours":      types.ListType{ElemType: types.Int64Type},
+	"weekdays":   types.ListType{ElemType: types.Int64Type},
 }
 
 type DatastoreClusterConfig struct {
@@ -72,6 +99,13 @@ func (d *Datastore) FromConfig(ctx context.Context, in *dfcloud.Datastore) {
 	d.Location.AvailabilityZones, _ = types.ListValueFrom(ctx, types.StringType, in.Config.Location.AvailabilityZones)
 	d.Addr = types.StringValue(in.Addr)
 	d.Password = types.StringValue(in.Key)
+	d.Status = types.StringValue(string(in.Status))
+	d.StatusDetail = types.StringValue(in.StatusDetail)
+	if in.Dashboard != nil && in.Dashboard.URL != "" {
+		d.DashboardURL = types.StringValue(in.Dashboard.URL)
+	} else {
+		d.DashboardURL = types.StringNull()
+	}
 	d.Tier.Memory = types.Int64Value(int64(in.Config.Tier.Memory))
 	d.Tier.PerformanceTier = types.StringValue(string(in.Config.Tier.PerformanceTier))
 
@@ -103,6 +137,23 @@ func (d *Datastore) FromConfig(ctx context.Context, in *dfcloud.Datastore) {
 		})
 	}
 
+	if policy := in.Config.BackupPolicy; policy != nil && policy.Enabled != nil {
+		// Schedules that aren't used are empty lists rather than null, to
+		// match the schema defaults.
+		hours, _ := types.ListValueFrom(ctx, types.Int64Type, append([]int{}, policy.Hours...))
+		weekDays, _ := types.ListValueFrom(ctx, types.Int64Type, append([]int{}, policy.WeekDays...))
+		d.BackupPolicy = types.ObjectValueMust(backupPolicyAttrTypes, map[string]attr.Value{
+			"enabled":    types.BoolPointerValue(policy.Enabled),
+			"retention":  types.Int64Value(int64(policy.Retention)),
+			"every_hour": types.BoolValue(lo.FromPtr(policy.EveryHour)),
+			"every_day":  types.BoolValue(lo.FromPtr(policy.EveryDay)),
+			"hours":      hours,
+			"weekdays":   weekDays,
+		})
+	} else {
+		d.BackupPolicy = types.ObjectNull(backupPolicyAttrTypes)
+	}
+
 	aclRules, _ := types.ListValueFrom(ctx, types.StringType, in.Config.Dragonfly.AclRules)
 
 	d.Dragonfly = types.ObjectValueMust(map[string]attr.Type{
@@ -132,6 +183,77 @@ func (d *Datastore) FromConfig(ctx context.Context, in *dfcloud.Datastore) {
 	}
 }
 
+// DatastoreDataSource maps the dfcloud_datastore data source schema data.
+// It has the same shape as Datastore, without the attributes that are only
+// sent to the API.
+type DatastoreDataSource struct {
+	ID                types.String      `tfsdk:"id"`
+	Name              types.String      `tfsdk:"name"`
+	NetworkId         types.String      `tfsdk:"network_id"`
+	Location          DatastoreLocation `tfsdk:"location"`
+	Tier              DatastoreTier     `tfsdk:"tier"`
+	Cluster           types.Object      `tfsdk:"cluster"`
+	Dragonfly         types.Object      `tfsdk:"dragonfly"`
+	CreatedAt         types.Int64       `tfsdk:"created_at"`
+	Password          types.String      `tfsdk:"password"`
+	Addr              types.String      `tfsdk:"addr"`
+	MaintenanceWindow types.Object      `tfsdk:"maintenance_window"`
+	BackupPolicy      types.Object      `tfsdk:"backup_policy"`
+	BYOCAccountID     types.String      `tfsdk:"byoc_account_id"`
+	Status            types.String      `tfsdk:"status"`
+	StatusDetail      types.String      `tfsdk:"status_detail"`
+	DashboardURL      types.String      `tfsdk:"dashboard_url"`
+}
+
+func (d *DatastoreDataSource) FromConfig(ctx context.Context, in *dfcloud.Datastore) {
+	var ds Datastore
+	ds.FromConfig(ctx, in)
+
+	d.ID = ds.ID
+	d.Name = ds.Name
+	d.NetworkId = ds.NetworkId
+	d.Location = ds.Location
+	d.Tier = ds.Tier
+	d.Cluster = ds.Cluster
+	d.Dragonfly = ds.Dragonfly
+	d.CreatedAt = ds.CreatedAt
+	d.Password = ds.Password
+	d.Addr = ds.Addr
+	d.MaintenanceWindow = ds.MaintenanceWindow
+	d.BackupPolicy = ds.BackupPolicy
+	d.BYOCAccountID = ds.BYOCAccountID
+	d.Status = ds.Status
+	d.StatusDetail = ds.StatusDetail
+	d.DashboardURL = ds.DashboardURL
+}
+
+// Datastores is the model of the dfcloud_datastores data source.
+type Datastores struct {
+	NameRegex       types.String           `tfsdk:"name_regex"`
+	Provider        types.String           `tfsdk:"cloud_provider"`
+	Region          types.String           `tfsdk:"region"`
+	PerformanceTier types.String           `tfsdk:"performance_tier"`
+	NetworkID       types.String           `tfsdk:"network_id"`
+	Status          types.String           `tfsdk:"status"`
+	BYOCAccountID   types.String           `tfsdk:"byoc_account_id"`
+	IDs             []types.String         `tfsdk:"ids"`
+	Datastores      []*DatastoreDataSource `tfsdk:"datastores"`
+}
+
+// IntoListDatastoresOptions converts the data source filters into list
+// options. The name regex is matched by the data source, since the API only
+// filters by name prefix.
+func IntoListDatastoresOptions(in Datastores) *dfcloud.ListDatastoresOptions {
+	return &dfcloud.ListDatastoresOptions{
+		NetworkID:       in.NetworkID.ValueString(),
+		Provider:        dfcloud.CloudProvider(in.Provider.ValueString()),
+		Region:          in.Region.ValueString(),
+		PerformanceTier: dfcloud.PerformanceTier(in.PerformanceTier.ValueString()),
+		Status:          dfcloud.DatastoreStatus(in.Status.ValueString()),
+		BYOCAccountID:   in.BYOCAccountID.ValueString(),
+	}
+}
+
 func IntoDatastoreConfig(in Datastore) *dfcloud.Datastore {
 	datastore := &dfcloud.Datastore{
 		ID: in.ID.ValueString(),
@@ -210,6 +332,16 @@ func IntoDatastoreConfig(in Datastore) *dfcloud.Datastore {
 		datastore.Config.MaintenanceWindow.DurationHours = lo.ToPtr(int(in.MaintenanceWindow.Attributes()["duration_hours"].(types.Int64).ValueInt64()))
 	}
 
+	if !in.BackupPolicy.IsNull() && !in.BackupPolicy.IsUnknown() {
+		var policy DatastoreBackupPolicy
+		_ = in.BackupPolicy.As(context.Background(), &policy, basetypes.ObjectAsOptions{})
+		datastore.Config.BackupPolicy = IntoBackupPolicy(policy)
+	}
+
+	if !in.RestoreFromBackupID.IsNull() && !in.RestoreFromBackupID.IsUnknown() {
+		datastore.Config.Restore.BackupId = in.RestoreFromBackupID.ValueString()
+	}
+
 	if !in.BYOCAccountID.IsNull() && !in.BYOCAccountID.IsUnknown() {
 		datastore.Config.BYOC.AccountID = in.BYOCAccountID.ValueString()
 	}
@@ -223,39 +355,24 @@ func IntoDatastoreConfig(in Datastore) *dfcloud.Datastore {
 	return datastore
 }
 
-// Helper function to wait for datastore to become active.
-func WaitForDatastoreStatus(ctx context.Context, client *dfcloud.Client, id string, status dfcloud.DatastoreStatus) (*dfcloud.Datastore, error) {
-	if id == "" {
-		return nil, fmt.Errorf("missing datastore id")
+// IntoBackupPolicy converts the backup_policy attribute into the API backup
+// policy. The schedule fields are always sent, as false or empty when not
+// set, since the API keeps the current value of omitted fields and switching
+// schedules would otherwise leave the previous schedule enabled.
+func IntoBackupPolicy(in DatastoreBackupPolicy) *dfcloud.BackupPolicy {
+	policy := &dfcloud.BackupPolicy{
+		Enabled:   in.Enabled.ValueBoolPointer(),
+		Retention: int(in.Retention.ValueInt64()),
+		EveryHour: lo.ToPtr(in.EveryHour.ValueBool()),
+		EveryDay:  lo.ToPtr(in.EveryDay.ValueBool()),
+		Hours:     []int{},
+		WeekDays:  []int{},
 	}
-	for {
-		datastore, err := client.GetDatastore(ctx, id)
-		if errors.Is(err, dfcloud.ErrNotFound) {
-			if status == dfcloud.DatastoreStatusDeleted {
-				return &dfcloud.Datastore{
-					ID:     id,
-					Status: dfcloud.DatastoreStatusDeleted,
-				}, nil
-			}
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-time.After(5 * time.Second):
-				continue
-			}
-		}
-		if err != nil {
-			return nil, err
-		}
-
-		if datastore.Status == status {
-			return datastore, nil
-		}
-
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-time.After(5 * time.Second):
-		}
+	if !in.Hours.IsNull() && !in.Hours.IsUnknown() {
+		_ = in.Hours.ElementsAs(context.Background(), &policy.Hours, false)
+	}
+	if !in.WeekDays.IsNull() && !in.WeekDays.IsUnknown() {
+		_ = in.WeekDays.ElementsAs(context.Background(), &policy.WeekDays, false)
 	}
+	return policy
 }
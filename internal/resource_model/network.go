@@ -1,12 +1,8 @@
 package resource_model
 
 import (
-	"context"
-	"errors"
-	"fmt"
-	"time"
-
 	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -25,6 +21,12 @@ type Network struct {
 	Status        types.String     `tfsdk:"status"`
 	Vpc           types.Object     `tfsdk:"vpc"`
 	BYOCAccountID types.String     `tfsdk:"byoc_account_id"`
+	Timeouts      timeouts.Value   `tfsdk:"timeouts"`
+}
+
+var networkVPCAttrTypes = map[string]attr.Type{
+	"resource_id": types.StringType,
+	"account_id":  types.StringType,
 }
 
 func IntoNetworkConfig(in Network) *dfcloud.NetworkConfig {
@@ -53,16 +55,17 @@ func FromNetworkConfig(in *dfcloud.Network) *Network {
 		CidrBlock: types.StringValue(in.CIDRBlock),
 		CreatedAt: types.Int64Value(in.CreatedAt),
 		Status:    types.StringValue(string(in.Status)),
-		Vpc: types.ObjectValueMust(
-			map[string]attr.Type{
-				"resource_id": types.StringType,
-				"account_id":  types.StringType,
-			},
+		Vpc:       types.ObjectNull(networkVPCAttrTypes),
+	}
+	// The VPC isn't known until the network has been provisioned.
+	if in.VPC != nil {
+		n.Vpc = types.ObjectValueMust(
+			networkVPCAttrTypes,
 			map[string]attr.Value{
 				"resource_id": types.StringValue(in.VPC.ResourceID),
 				"account_id":  types.StringValue(in.VPC.AccountID),
 			},
-		),
+		)
 	}
 	if in.BYOC.AccountID != "" {
 		n.BYOCAccountID = types.StringValue(in.BYOC.AccountID)
@@ -72,38 +75,53 @@ func FromNetworkConfig(in *dfcloud.Network) *Network {
 	return n
 }
 
-func WaitUntilNetworkStatus(ctx context.Context, client *dfcloud.Client, id string, status dfcloud.NetworkStatus) (*dfcloud.Network, error) {
-	if id == "" {
-		return nil, fmt.Errorf("missing network id")
+// NetworkDataSource maps the dfcloud_network data source schema data.
+type NetworkDataSource struct {
+	Id            types.String     `tfsdk:"id"`
+	Name          types.String     `tfsdk:"name"`
+	Location      *NetworkLocation `tfsdk:"location"`
+	CidrBlock     types.String     `tfsdk:"cidr_block"`
+	CreatedAt     types.Int64      `tfsdk:"created_at"`
+	Status        types.String     `tfsdk:"status"`
+	StatusDetail  types.String     `tfsdk:"status_detail"`
+	Vpc           types.Object     `tfsdk:"vpc"`
+	BYOCAccountID types.String     `tfsdk:"byoc_account_id"`
+}
+
+func FromNetworkDataSourceConfig(in *dfcloud.Network) *NetworkDataSource {
+	n := FromNetworkConfig(in)
+	return &NetworkDataSource{
+		Id:            n.Id,
+		Name:          n.Name,
+		Location:      n.Location,
+		CidrBlock:     n.CidrBlock,
+		CreatedAt:     n.CreatedAt,
+		Status:        n.Status,
+		StatusDetail:  types.StringValue(in.StatusDetail),
+		Vpc:           n.Vpc,
+		BYOCAccountID: n.BYOCAccountID,
 	}
-	for {
-		network, err := client.GetNetwork(ctx, id)
-		if errors.Is(err, dfcloud.ErrNotFound) {
-			if status == dfcloud.NetworkStatusDeleted {
-				return &dfcloud.Network{
-					ID:     id,
-					Status: dfcloud.NetworkStatusDeleted,
-				}, nil
-			}
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-time.After(5 * time.Second):
-				continue
-			}
-		}
-		if err != nil {
-			return nil, err
-		}
+}
 
-		if network.Status == status {
-			return network, nil
-		}
+// Networks is the model of the dfcloud_networks data source.
+type Networks struct {
+	NameRegex     types.String         `tfsdk:"name_regex"`
+	Provider      types.String         `tfsdk:"cloud_provider"`
+	Region        types.String         `tfsdk:"region"`
+	Status        types.String         `tfsdk:"status"`
+	BYOCAccountID types.String         `tfsdk:"byoc_account_id"`
+	IDs           []types.String       `tfsdk:"ids"`
+	Networks      []*NetworkDataSource `tfsdk:"networks"`
+}
 
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-time.After(5 * time.Second):
-		}
+// IntoListNetworksOptions converts the data source filters into list
+// options. The name regex is matched by the data source, since the API only
+// filters by name prefix.
+func IntoListNetworksOptions(in Networks) *dfcloud.ListNetworksOptions {
+	return &dfcloud.ListNetworksOptions{
+		Provider:      dfcloud.CloudProvider(in.Provider.ValueString()),
+		Region:        in.Region.ValueString(),
+		Status:        dfcloud.NetworkStatus(in.Status.ValueString()),
+		BYOCAccountID: in.BYOCAccountID.ValueString(),
 	}
 }
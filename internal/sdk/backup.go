@@ -0,0 +1,113 @@
+package sdk
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// BackupStatus represents the current status of the backup.
+type BackupStatus string
+
+const (
+	// BackupStatusPending is set when the backup has been requested and it
+	// is being asynchronously taken.
+	BackupStatusPending BackupStatus = "pending"
+	// BackupStatusActive is set when the backup has been taken and can be
+	// restored.
+	BackupStatusActive BackupStatus = "active"
+	// BackupStatusFailed is set when the backup was requested but could not
+	// be taken.
+	BackupStatusFailed BackupStatus = "failed"
+	// BackupStatusDeleting is set when the user has requested the backup to
+	// be deleted and it is being asynchronously deleted.
+	BackupStatusDeleting BackupStatus = "deleting"
+	// BackupStatusDeleted is set when the backup has been deleted.
+	BackupStatusDeleted BackupStatus = "deleted"
+)
+
+// BackupType indicates how the backup was taken.
+type BackupType string
+
+const (
+	// BackupTypeScheduled is set for backups taken by the datastores
+	// backup policy.
+	BackupTypeScheduled BackupType = "scheduled"
+	// BackupTypeManual is set for backups requested by the user.
+	BackupTypeManual BackupType = "manual"
+)
+
+// BackupConfig contains the backups configurable fields.
+type BackupConfig struct {
+	// DatastoreID is the ID of the datastore to back up.
+	DatastoreID string `json:"datastore_id"`
+}
+
+// Backup represents a snapshot of a datastore.
+type Backup struct {
+	ID string `json:"backup_id"`
+
+	DatastoreID string `json:"datastore_id"`
+
+	Type BackupType `json:"type"`
+
+	Status BackupStatus `json:"status"`
+
+	// StatusDetail provides more information on the status of the backup.
+	StatusDetail string `json:"status_detail,omitempty"`
+
+	// SizeBytes is the size of the backup in bytes.
+	SizeBytes int64 `json:"size_bytes"`
+
+	CreatedAt int64 `json:"created_at"`
+
+	// ExpiresAt is when the backup will be deleted, based on the datastores
+	// backup retention, or 0 if the backup doesn't expire.
+	ExpiresAt int64 `json:"expires_at"`
+}
+
+// ListBackupsOptions filters the backups returned by [Client.ListBackups].
+// Empty fields don't filter.
+type ListBackupsOptions struct {
+	// DatastoreID only includes backups of the given datastore.
+	DatastoreID string
+	// Type only includes backups of the given type.
+	Type BackupType
+	// Status only includes backups with the given status.
+	Status BackupStatus
+	// CreatedAfter only includes backups created at or after the given unix
+	// timestamp.
+	CreatedAfter int64
+	// CreatedBefore only includes backups created at or before the given
+	// unix timestamp.
+	CreatedBefore int64
+}
+
+func (o *ListBackupsOptions) values() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	setQuery(q, "datastore_id", o.DatastoreID)
+	setQuery(q, "type", string(o.Type))
+	setQuery(q, "status", string(o.Status))
+	if o.CreatedAfter != 0 {
+		q.Set("created_after", strconv.FormatInt(o.CreatedAfter, 10))
+	}
+	if o.CreatedBefore != 0 {
+		q.Set("created_before", strconv.FormatInt(o.CreatedBefore, 10))
+	}
+	return q
+}
+
+// matches reports whether the backup passes the filters, in case the API
+// ignored any of them.
+func (o *ListBackupsOptions) matches(b *Backup) bool {
+	if o == nil {
+		return true
+	}
+	return matchFilter(o.DatastoreID, b.DatastoreID) &&
+		matchFilter(o.Type, b.Type) &&
+		matchFilter(o.Status, b.Status) &&
+		(o.CreatedAfter == 0 || b.CreatedAt >= o.CreatedAfter) &&
+		(o.CreatedBefore == 0 || b.CreatedAt <= o.CreatedBefore)
+}
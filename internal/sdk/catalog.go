@@ -0,0 +1,76 @@
+package sdk
+
+import (
+	_ "embed"
+	"encoding/json"
+	"slices"
+)
+
+// defaultCatalog is the catalog used when the API doesn't serve one. Keep
+// it in sync with the API.
+//
+//go:embed catalog.json
+var defaultCatalog []byte
+
+// Catalog describes where datastores can be provisioned and the sizes they
+// can be provisioned with.
+type Catalog struct {
+	Providers []CatalogProvider `json:"providers"`
+}
+
+// CatalogProvider describes the regions and performance tiers of a cloud
+// provider.
+type CatalogProvider struct {
+	Provider         CloudProvider   `json:"provider"`
+	Regions          []CatalogRegion `json:"regions"`
+	PerformanceTiers []CatalogTier   `json:"performance_tiers"`
+}
+
+// CatalogRegion describes a region of a cloud provider.
+type CatalogRegion struct {
+	Region string `json:"region"`
+	// AvailabilityZones are the availability zones datastores can use in
+	// the region.
+	AvailabilityZones []string `json:"availability_zones"`
+}
+
+// CatalogTier describes the sizes of a performance tier.
+type CatalogTier struct {
+	PerformanceTier PerformanceTier `json:"performance_tier"`
+	// MaxMemoryBytes are the permitted max_memory_bytes of non-cluster
+	// datastores.
+	MaxMemoryBytes []uint64 `json:"max_memory_bytes"`
+	// ShardMemoryBytes are the permitted cluster shard sizes. The memory of
+	// cluster datastores must be a multiple of the shard size. Empty if the
+	// shard sizes of the tier aren't listed.
+	ShardMemoryBytes []uint64 `json:"shard_memory_bytes"`
+}
+
+// DefaultCatalog returns the catalog embedded in the SDK, for when the API
+// doesn't serve one.
+func DefaultCatalog() *Catalog {
+	var catalog Catalog
+	if err := json.Unmarshal(defaultCatalog, &catalog); err != nil {
+		panic("sdk: invalid embedded catalog: " + err.Error())
+	}
+	return &catalog
+}
+
+// Tier returns the performance tier of the provider, or nil if the provider
+// doesn't support it.
+func (c *Catalog) Tier(provider CloudProvider, tier PerformanceTier) *CatalogTier {
+	i := slices.IndexFunc(c.Providers, func(p CatalogProvider) bool {
+		return p.Provider == provider
+	})
+	if i < 0 {
+		return nil
+	}
+	tiers := c.Providers[i].PerformanceTiers
+	j := slices.IndexFunc(tiers, func(t CatalogTier) bool {
+		return t.PerformanceTier == tier
+	})
+	if j < 0 {
+		return nil
+	}
+	return &tiers[j]
+}
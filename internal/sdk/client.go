@@ -7,20 +7,34 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"os"
+	"strings"
 	"time"
 )
 
-type errorResponse struct {
-	Error string `json:"error"`
-}
-
 type clientOptions struct {
-	apiKey  string
-	apiHost string
-	timeout time.Duration
+	apiKey      string
+	apiHost     string
+	timeout     time.Duration
+	retryPolicy RetryPolicy
+	pageSize    int
+
+	clock           Clock
+	minPollInterval time.Duration
+	maxPollInterval time.Duration
+
+	requestLogger    RequestLogger
+	logRequestBodies bool
+	waitLogger       WaitLogger
+
+	httpClient         *http.Client
+	transport          http.RoundTripper
+	caCertFile         string
+	proxyURL           string
+	insecureSkipVerify bool
 }
 
 type ClientOption interface {
@@ -65,27 +79,77 @@ func (o apiHostOption) apply(opts *clientOptions) {
 }
 
 // WithAPIHost configures the client to use the given API URL.
+//
+// The URL may be a bare hostname, such as "api.dragonflydb.cloud", which is
+// accessed over HTTPS, or a base URL including the scheme and an optional
+// path prefix, such as "http://localhost:8080" or
+// "https://gateway.internal/dfcloud".
 func WithAPIHost(url string) ClientOption {
 	return apiHostOption(url)
 }
 
+// DefaultAPIHost is the Dragonfly cloud API host used when none is
+// configured.
+const DefaultAPIHost = "api.dragonflydb.cloud"
+
+// parseBaseURL parses the configured API host, which is either a bare
+// hostname or a base URL with a scheme and optional path prefix.
+func parseBaseURL(host string) (*url.URL, error) {
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid api host: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid api host: %q: scheme must be http or https", host)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid api host: %q: missing host", host)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return nil, fmt.Errorf("invalid api host: %q: must not contain a query or fragment", host)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = ""
+
+	return u, nil
+}
+
 // Client represents a REST client for the Dragonfly cloud API.
 type Client struct {
 	apiKey  string
-	apiHost string
+	baseURL *url.URL
+
+	retryPolicy RetryPolicy
+	pageSize    int
+
+	clock           Clock
+	minPollInterval time.Duration
+	maxPollInterval time.Duration
+
+	requestLogger    RequestLogger
+	logRequestBodies bool
+	waitLogger       WaitLogger
 
 	httpClient *http.Client
 }
 
-var ErrNotFound = errors.New("not found")
-
 // NewClient creates a Dragonfly cloud client.
 //
 // The client options must include either [WithAPIKey] or [WithAPIKeyFromEnv]
 // to authenticate with Dragonfly cloud.
 func NewClient(opts ...ClientOption) (*Client, error) {
 	options := clientOptions{
-		timeout: time.Second * 15,
+		timeout:     time.Second * 15,
+		retryPolicy: DefaultRetryPolicy,
+		pageSize:    DefaultPageSize,
+
+		clock:           realClock{},
+		minPollInterval: DefaultMinPollInterval,
+		maxPollInterval: DefaultMaxPollInterval,
 	}
 	for _, o := range opts {
 		o.apply(&options)
@@ -95,22 +159,44 @@ func NewClient(opts ...ClientOption) (*Client, error) {
 		return nil, fmt.Errorf("missing api key")
 	}
 
+	if options.minPollInterval <= 0 || options.maxPollInterval < options.minPollInterval {
+		return nil, fmt.Errorf("invalid poll interval: min %s, max %s", options.minPollInterval, options.maxPollInterval)
+	}
+
 	if options.apiHost == "" {
 		// use default
-		options.apiHost = "api.dragonflydb.cloud"
+		options.apiHost = DefaultAPIHost
+	}
+
+	baseURL, err := parseBaseURL(options.apiHost)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := newHTTPClient(options)
+	if err != nil {
+		return nil, err
 	}
 
 	return &Client{
-		apiKey: options.apiKey,
-		httpClient: &http.Client{
-			Timeout: options.timeout,
-		},
-		apiHost: options.apiHost,
+		apiKey:      options.apiKey,
+		httpClient:  httpClient,
+		baseURL:     baseURL,
+		retryPolicy: options.retryPolicy,
+		pageSize:    options.pageSize,
+
+		clock:           options.clock,
+		minPollInterval: options.minPollInterval,
+		maxPollInterval: options.maxPollInterval,
+
+		requestLogger:    options.requestLogger,
+		logRequestBodies: options.logRequestBodies,
+		waitLogger:       options.waitLogger,
 	}, nil
 }
 
 func (c *Client) GetDatastore(ctx context.Context, id string) (*Datastore, error) {
-	r, err := c.request(ctx, http.MethodGet, "/v1/datastores/"+id, nil)
+	r, err := c.request(ctx, http.MethodGet, "/v1/datastores/"+id, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +213,7 @@ func (c *Client) GetDatastore(ctx context.Context, id string) (*Datastore, error
 func (c *Client) CreateDatastore(ctx context.Context, config *DatastoreConfig) (*Datastore, error) {
 	b, _ := json.Marshal(&config)
 
-	r, err := c.request(ctx, http.MethodPost, "/v1/datastores", b)
+	r, err := c.request(ctx, http.MethodPost, "/v1/datastores", nil, b)
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +230,7 @@ func (c *Client) CreateDatastore(ctx context.Context, config *DatastoreConfig) (
 func (c *Client) UpdateDatastore(ctx context.Context, id string, config *DatastoreConfig) (*Datastore, error) {
 	b, _ := json.Marshal(&config)
 
-	r, err := c.request(ctx, http.MethodPut, "/v1/datastores/"+id, b)
+	r, err := c.request(ctx, http.MethodPut, "/v1/datastores/"+id, nil, b)
 	if err != nil {
 		return nil, err
 	}
@@ -158,34 +244,64 @@ func (c *Client) UpdateDatastore(ctx context.Context, id string, config *Datasto
 	return &datastore, nil
 }
 
-// ListDatastores lists all the customers datastores.
-func (c *Client) ListDatastores(ctx context.Context) ([]*Datastore, error) {
-	r, err := c.request(ctx, http.MethodGet, "/v1/datastores", nil)
+// ListDatastores lists the customers datastores matching opts. If opts is
+// nil, all datastores are listed.
+func (c *Client) ListDatastores(ctx context.Context, opts *ListDatastoresOptions) ([]*Datastore, error) {
+	return All(c.IterDatastores(ctx, opts))
+}
+
+// IterDatastores iterates over the customers datastores matching opts,
+// fetching pages lazily.
+func (c *Client) IterDatastores(ctx context.Context, opts *ListDatastoresOptions) iter.Seq2[*Datastore, error] {
+	return filter(
+		paginate[*Datastore](ctx, c, "/v1/datastores", "datastores", opts.values()),
+		opts.matches,
+	)
+}
+
+func (c *Client) DeleteDatastore(ctx context.Context, id string) error {
+	r, err := c.request(ctx, http.MethodDelete, "/v1/datastores/"+id, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	return nil
+}
+
+// GetCatalog returns the catalog of providers, regions and sizes datastores
+// can be provisioned with.
+func (c *Client) GetCatalog(ctx context.Context) (*Catalog, error) {
+	r, err := c.request(ctx, http.MethodGet, "/v1/catalog", nil, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer r.Close()
 
-	var datastores []*Datastore
-	if err := json.NewDecoder(r).Decode(&datastores); err != nil {
+	var catalog Catalog
+	if err := json.NewDecoder(r).Decode(&catalog); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 
-	return datastores, nil
+	return &catalog, nil
 }
 
-func (c *Client) DeleteDatastore(ctx context.Context, id string) error {
-	r, err := c.request(ctx, http.MethodDelete, "/v1/datastores/"+id, nil)
+// GetCatalogOrDefault is like GetCatalog, but falls back to
+// [DefaultCatalog] if the API doesn't serve a catalog. It reports whether
+// the catalog came from the API.
+func (c *Client) GetCatalogOrDefault(ctx context.Context) (*Catalog, bool, error) {
+	catalog, err := c.GetCatalog(ctx)
+	if errors.Is(err, ErrNotFound) {
+		return DefaultCatalog(), false, nil
+	}
 	if err != nil {
-		return err
+		return nil, false, err
 	}
-	defer r.Close()
-
-	return nil
+	return catalog, true, nil
 }
 
 func (c *Client) GetNetwork(ctx context.Context, id string) (*Network, error) {
-	r, err := c.request(ctx, http.MethodGet, "/v1/networks/"+id, nil)
+	r, err := c.request(ctx, http.MethodGet, "/v1/networks/"+id, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -202,7 +318,7 @@ func (c *Client) GetNetwork(ctx context.Context, id string) (*Network, error) {
 func (c *Client) CreateNetwork(ctx context.Context, config *NetworkConfig) (*Network, error) {
 	b, _ := json.Marshal(&config)
 
-	r, err := c.request(ctx, http.MethodPost, "/v1/networks", b)
+	r, err := c.request(ctx, http.MethodPost, "/v1/networks", nil, b)
 	if err != nil {
 		return nil, err
 	}
@@ -215,26 +331,25 @@ func (c *Client) CreateNetwork(ctx context.Context, config *NetworkConfig) (*Net
 	return &network, nil
 }
 
-// ListNetworks lists all the customers networks.
-func (c *Client) ListNetworks(ctx context.Context) ([]*Network, error) {
-	r, err := c.request(ctx, http.MethodGet, "/v1/networks", nil)
-	if err != nil {
-		return nil, err
-	}
-	defer r.Close()
-
-	var networks []*Network
-	if err := json.NewDecoder(r).Decode(&networks); err != nil {
-		return nil, fmt.Errorf("decode response: %w", err)
-	}
+// ListNetworks lists the customers networks matching opts. If opts is nil,
+// all networks are listed.
+func (c *Client) ListNetworks(ctx context.Context, opts *ListNetworksOptions) ([]*Network, error) {
+	return All(c.IterNetworks(ctx, opts))
+}
 
-	return networks, nil
+// IterNetworks iterates over the customers networks matching opts, fetching
+// pages lazily.
+func (c *Client) IterNetworks(ctx context.Context, opts *ListNetworksOptions) iter.Seq2[*Network, error] {
+	return filter(
+		paginate[*Network](ctx, c, "/v1/networks", "networks", opts.values()),
+		opts.matches,
+	)
 }
 
 func (c *Client) UpdateNetwork(ctx context.Context, id string, config *NetworkConfig) (*Network, error) {
 	b, _ := json.Marshal(&config)
 
-	r, err := c.request(ctx, http.MethodPut, "/v1/networks/"+id, b)
+	r, err := c.request(ctx, http.MethodPut, "/v1/networks/"+id, nil, b)
 	if err != nil {
 		return nil, err
 	}
@@ -249,7 +364,7 @@ func (c *Client) UpdateNetwork(ctx context.Context, id string, config *NetworkCo
 }
 
 func (c *Client) DeleteNetwork(ctx context.Context, id string) error {
-	r, err := c.request(ctx, http.MethodDelete, "/v1/networks/"+id, nil)
+	r, err := c.request(ctx, http.MethodDelete, "/v1/networks/"+id, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -259,7 +374,7 @@ func (c *Client) DeleteNetwork(ctx context.Context, id string) error {
 }
 
 func (c *Client) GetConnection(ctx context.Context, id string) (*Connection, error) {
-	r, err := c.request(ctx, http.MethodGet, "/v1/connections/"+id, nil)
+	r, err := c.request(ctx, http.MethodGet, "/v1/connections/"+id, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -275,7 +390,7 @@ func (c *Client) GetConnection(ctx context.Context, id string) (*Connection, err
 func (c *Client) CreateConnection(ctx context.Context, config *ConnectionConfig) (*Connection, error) {
 	b, _ := json.Marshal(&config)
 
-	r, err := c.request(ctx, http.MethodPost, "/v1/connections", b)
+	r, err := c.request(ctx, http.MethodPost, "/v1/connections", nil, b)
 	if err != nil {
 		return nil, err
 	}
@@ -288,24 +403,80 @@ func (c *Client) CreateConnection(ctx context.Context, config *ConnectionConfig)
 	return &conn, nil
 }
 
-// ListConnection lists all the customers connections.
-func (c *Client) ListConnections(ctx context.Context) ([]*Connection, error) {
-	r, err := c.request(ctx, http.MethodGet, "/v1/connections", nil)
+// ListConnections lists the customers connections matching opts. If opts is
+// nil, all connections are listed.
+func (c *Client) ListConnections(ctx context.Context, opts *ListConnectionsOptions) ([]*Connection, error) {
+	return All(c.IterConnections(ctx, opts))
+}
+
+// IterConnections iterates over the customers connections matching opts,
+// fetching pages lazily.
+func (c *Client) IterConnections(ctx context.Context, opts *ListConnectionsOptions) iter.Seq2[*Connection, error] {
+	return filter(
+		paginate[*Connection](ctx, c, "/v1/connections", "connections", opts.values()),
+		opts.matches,
+	)
+}
+
+func (c *Client) DeleteConnection(ctx context.Context, id string) error {
+	r, err := c.request(ctx, http.MethodDelete, "/v1/connections/"+id, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	return nil
+}
+
+func (c *Client) GetBackup(ctx context.Context, id string) (*Backup, error) {
+	r, err := c.request(ctx, http.MethodGet, "/v1/backups/"+id, nil, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer r.Close()
 
-	var conns []*Connection
-	if err := json.NewDecoder(r).Decode(&conns); err != nil {
+	var backup Backup
+	if err := json.NewDecoder(r).Decode(&backup); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
+	return &backup, nil
+}
+
+// CreateBackup requests an on-demand backup of a datastore. The backup is
+// taken asynchronously.
+func (c *Client) CreateBackup(ctx context.Context, config *BackupConfig) (*Backup, error) {
+	b, _ := json.Marshal(&config)
 
-	return conns, nil
+	r, err := c.request(ctx, http.MethodPost, "/v1/backups", nil, b)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var backup Backup
+	if err := json.NewDecoder(r).Decode(&backup); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &backup, nil
 }
 
-func (c *Client) DeleteConnection(ctx context.Context, id string) error {
-	r, err := c.request(ctx, http.MethodDelete, "/v1/connections/"+id, nil)
+// ListBackups lists the customers backups matching opts. If opts is nil, all
+// backups are listed.
+func (c *Client) ListBackups(ctx context.Context, opts *ListBackupsOptions) ([]*Backup, error) {
+	return All(c.IterBackups(ctx, opts))
+}
+
+// IterBackups iterates over the customers backups matching opts, fetching
+// pages lazily.
+func (c *Client) IterBackups(ctx context.Context, opts *ListBackupsOptions) iter.Seq2[*Backup, error] {
+	return filter(
+		paginate[*Backup](ctx, c, "/v1/backups", "backups", opts.values()),
+		opts.matches,
+	)
+}
+
+func (c *Client) DeleteBackup(ctx context.Context, id string) error {
+	r, err := c.request(ctx, http.MethodDelete, "/v1/backups/"+id, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -318,14 +489,64 @@ func (c *Client) request(
 	ctx context.Context,
 	method string,
 	path string,
+	query url.Values,
 	body []byte,
 ) (io.ReadCloser, error) {
-	url := &url.URL{
-		Scheme: "https",
-		Host:   c.apiHost,
-		Path:   path,
+	// Create requests always carry an idempotency key, which is reused
+	// across retries so a retried create can't create a duplicate. Unless the
+	// caller sets one, the key is random per call, so only retries within
+	// this call are deduplicated.
+	idempotent := isIdempotent(method)
+	if method == http.MethodPost {
+		if _, ok := IdempotencyKeyFromContext(ctx); !ok {
+			ctx = WithIdempotencyKey(ctx, NewIdempotencyKey())
+		}
+		idempotent = true
 	}
 
+	for attempt := 0; ; attempt++ {
+		resp, err := c.do(ctx, method, path, query, body, attempt+1)
+
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		if (err == nil && status == http.StatusOK) ||
+			attempt >= c.retryPolicy.MaxRetries ||
+			ctx.Err() != nil ||
+			!shouldRetry(idempotent, status, err) {
+			if err != nil {
+				return nil, err
+			}
+			return c.handleResponse(resp)
+		}
+
+		delay := c.retryPolicy.delay(attempt+1, resp, c.clock.Now())
+		if resp != nil {
+			// Drain the body so the connection can be reused.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-c.clock.After(delay):
+		}
+	}
+}
+
+func (c *Client) do(
+	ctx context.Context,
+	method string,
+	path string,
+	query url.Values,
+	body []byte,
+	attempt int,
+) (*http.Response, error) {
+	url := c.baseURL.JoinPath(path)
+	url.RawQuery = query.Encode()
+
 	var b io.Reader
 	if body != nil {
 		b = bytes.NewReader(body)
@@ -336,28 +557,70 @@ func (c *Client) request(
 		return nil, fmt.Errorf("request: %w", err)
 	}
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+	if key, ok := IdempotencyKeyFromContext(ctx); ok && method == http.MethodPost {
+		req.Header.Set("Idempotency-Key", key)
+	}
+
+	if c.requestLogger == nil {
+		return c.httpClient.Do(req)
+	}
 
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
+	entry := RequestLog{
+		Method:         method,
+		URL:            url.String(),
+		Attempt:        attempt,
+		RequestHeaders: redactHeaders(req.Header),
+		Duration:       time.Since(start),
+		Err:            err,
+	}
+	if c.logRequestBodies {
+		entry.RequestBody = redactBody(body)
+	}
+	if resp != nil {
+		entry.StatusCode = resp.StatusCode
+	}
+	if resp != nil && c.logRequestBodies {
+		// Buffer the body so it can be both logged and decoded.
+		respBody, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if readErr != nil && err == nil {
+			err = fmt.Errorf("read response: %w", readErr)
+			entry.Err = err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+		entry.ResponseBody = redactBody(respBody)
+		entry.Duration = time.Since(start)
+	}
+	c.requestLogger(ctx, entry)
+
 	if err != nil {
 		return nil, err
 	}
+	return resp, nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		defer resp.Body.Close()
+func (c *Client) handleResponse(resp *http.Response) (io.ReadCloser, error) {
+	if resp.StatusCode == http.StatusOK {
+		return resp.Body, nil
+	}
+	defer resp.Body.Close()
 
-		if resp.StatusCode == http.StatusNotFound {
-			return nil, ErrNotFound
-		}
-		if resp.StatusCode >= http.StatusBadRequest &&
-			resp.StatusCode < http.StatusInternalServerError {
-			var errResp errorResponse
-			if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-				return nil, fmt.Errorf("bad status: %d", resp.StatusCode)
-			}
-			return nil, fmt.Errorf("bad status: %d: %s", resp.StatusCode, errResp.Error)
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+	}
+
+	var errResp errorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil {
+		apiErr.Message = errResp.Error
+		apiErr.Details = errResp.Details
+		if errResp.RequestID != "" {
+			apiErr.RequestID = errResp.RequestID
 		}
-		return nil, fmt.Errorf("bad status: %d", resp.StatusCode)
 	}
 
-	return resp.Body, nil
+	return nil, apiErr
 }
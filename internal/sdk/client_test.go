@@ -2,31 +2,70 @@ package sdk
 
 import (
 	"context"
+	"encoding/json"
+	"encoding/pem"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
-func newTestClient(t *testing.T, handler http.Handler) *Client {
+func newTestClient(t *testing.T, handler http.Handler, opts ...ClientOption) *Client {
 	t.Helper()
 
 	srv := httptest.NewTLSServer(handler)
 	t.Cleanup(srv.Close)
 
-	client, err := NewClient(
+	client, err := NewClient(append([]ClientOption{
 		WithAPIKey("test-key"),
-		WithAPIHost(strings.TrimPrefix(srv.URL, "https://")),
-	)
+		WithAPIHost(srv.URL),
+		WithHTTPClient(srv.Client()),
+	}, opts...)...)
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
-	client.httpClient = srv.Client()
 
 	return client
 }
 
+// fakeClock is a [Clock] whose timers fire immediately, advancing the
+// clock, so tests don't wait in real time.
+type fakeClock struct {
+	mu    sync.Mutex
+	start time.Time
+	now   time.Time
+	waits []time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &fakeClock{start: start, now: start}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	c.waits = append(c.waits, d)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
 func TestGetNetwork(t *testing.T) {
 	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
@@ -76,3 +115,758 @@ func TestGetNetworkReturnsNotFound(t *testing.T) {
 		t.Fatalf("GetNetwork() error = %v, want ErrNotFound", err)
 	}
 }
+
+func TestRequestRetriesServerErrors(t *testing.T) {
+	var attempts int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"network_id":"network-1"}`))
+	}))
+	client.retryPolicy = RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+	got, err := client.GetNetwork(context.Background(), "network-1")
+	if err != nil {
+		t.Fatalf("GetNetwork() error = %v", err)
+	}
+	if got.ID != "network-1" {
+		t.Fatalf("GetNetwork() ID = %q, want %q", got.ID, "network-1")
+	}
+	if attempts != 3 {
+		t.Fatalf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestRequestRetriesCreateWithIdempotencyKey(t *testing.T) {
+	var keys []string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"network_id":"network-1"}`))
+	}))
+	client.retryPolicy = RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+	_, err := client.CreateNetwork(context.Background(), &NetworkConfig{Name: "one"})
+	if err != nil {
+		t.Fatalf("CreateNetwork() error = %v", err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("attempts = %d, want 2", len(keys))
+	}
+	if keys[0] == "" || keys[0] != keys[1] {
+		t.Fatalf("Idempotency-Key headers = %q, want the same non-empty key on each attempt", keys)
+	}
+}
+
+func TestRequestUsesIdempotencyKeyFromContext(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Idempotency-Key"); got != "create-1" {
+			t.Fatalf("Idempotency-Key = %q, want %q", got, "create-1")
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"datastore_id":"ds-1"}`))
+	}))
+
+	ctx := WithIdempotencyKey(context.Background(), "create-1")
+	if _, err := client.CreateDatastore(ctx, &DatastoreConfig{Name: "one"}); err != nil {
+		t.Fatalf("CreateDatastore() error = %v", err)
+	}
+}
+
+func TestRequestRetriesRateLimitedWithRetryAfter(t *testing.T) {
+	var attempts int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"network_id":"network-1"}`))
+	}))
+	// A large minimum backoff ensures the test only passes if Retry-After is
+	// honoured.
+	client.retryPolicy = RetryPolicy{MaxRetries: 1, MinBackoff: time.Hour, MaxBackoff: time.Hour}
+
+	_, err := client.CreateNetwork(context.Background(), &NetworkConfig{Name: "one"})
+	if err != nil {
+		t.Fatalf("CreateNetwork() error = %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("attempts = %d, want 2", attempts)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{value: "", ok: false},
+		{value: "3", want: 3 * time.Second, ok: true},
+		{value: "-1", ok: false},
+		{value: "Mon, 01 Jan 2024 00:00:10 GMT", want: 10 * time.Second, ok: true},
+		{value: "Sun, 31 Dec 2023 23:59:00 GMT", want: 0, ok: true},
+		{value: "soon", ok: false},
+	}
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.value, now)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, %v, want %v, %v", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestRequestReturnsAPIError(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-header")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"error":"invalid datastore config","details":[{"field":"tier.max_memory_bytes","reason":"not permitted for tier"}],"request_id":"req-1"}`))
+	}))
+
+	_, err := client.CreateDatastore(context.Background(), &DatastoreConfig{Name: "one"})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("CreateDatastore() error = %v, want ErrValidation", err)
+	}
+	if errors.Is(err, ErrConflict) {
+		t.Fatalf("CreateDatastore() error = %v, should not match ErrConflict", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("CreateDatastore() error = %T, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("StatusCode = %d, want %d", apiErr.StatusCode, http.StatusUnprocessableEntity)
+	}
+	if apiErr.Message != "invalid datastore config" {
+		t.Fatalf("Message = %q, want %q", apiErr.Message, "invalid datastore config")
+	}
+	if apiErr.RequestID != "req-1" {
+		t.Fatalf("RequestID = %q, want %q", apiErr.RequestID, "req-1")
+	}
+	if len(apiErr.Details) != 1 || apiErr.Details[0].Field != "tier.max_memory_bytes" {
+		t.Fatalf("Details = %+v, want one tier.max_memory_bytes detail", apiErr.Details)
+	}
+}
+
+func TestErrorDetailPath(t *testing.T) {
+	tests := []struct {
+		field string
+		want  []string
+	}{
+		{field: "", want: nil},
+		{field: "name", want: []string{"name"}},
+		{field: "tier.max_memory_bytes", want: []string{"tier", "max_memory_bytes"}},
+		{field: "location.availability_zones[1]", want: []string{"location", "availability_zones", "1"}},
+		{field: "backup_policy.hours[0][1]", want: []string{"backup_policy", "hours", "0", "1"}},
+		{field: "/dragonfly/acl_rules/2", want: []string{"dragonfly", "acl_rules", "2"}},
+	}
+	for _, tt := range tests {
+		got := ErrorDetail{Field: tt.field}.Path()
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("ErrorDetail{Field: %q}.Path() = %q, want %q", tt.field, got, tt.want)
+		}
+	}
+}
+
+func TestListDatastoresPaginates(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/datastores" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("page_size"); got != "2" {
+			t.Fatalf("page_size = %q, want %q", got, "2")
+		}
+
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Query().Get("page_token") {
+		case "":
+			_, _ = w.Write([]byte(`{"datastores":[{"datastore_id":"ds-1"},{"datastore_id":"ds-2"}],"next_page_token":"page-2"}`))
+		case "page-2":
+			_, _ = w.Write([]byte(`{"datastores":[{"datastore_id":"ds-3"}],"next_page_token":""}`))
+		default:
+			t.Fatalf("unexpected page token %q", r.URL.Query().Get("page_token"))
+		}
+	}))
+	client.pageSize = 2
+
+	got, err := client.ListDatastores(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListDatastores() error = %v", err)
+	}
+
+	var ids []string
+	for _, ds := range got {
+		ids = append(ids, ds.ID)
+	}
+	if want := []string{"ds-1", "ds-2", "ds-3"}; !slices.Equal(ids, want) {
+		t.Fatalf("ListDatastores() IDs = %q, want %q", ids, want)
+	}
+}
+
+func TestListDatastoresPageTokenCycle(t *testing.T) {
+	var requests int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests > 10 {
+			t.Fatalf("too many requests, pagination didn't stop")
+		}
+
+		// The pages cycle from A to B back to A.
+		next := "A"
+		if r.URL.Query().Get("page_token") == "A" {
+			next = "B"
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"datastores":[{"datastore_id":"ds-%d"}],"next_page_token":%q}`, requests, next)
+	}))
+
+	_, err := client.ListDatastores(context.Background(), nil)
+	if err == nil || !strings.Contains(err.Error(), `repeated page token "A"`) {
+		t.Fatalf("ListDatastores() error = %v, want repeated page token", err)
+	}
+	if requests != 3 {
+		t.Fatalf("requests = %d, want 3", requests)
+	}
+}
+
+func TestListNetworksUnpaginated(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[{"network_id":"network-1"},{"network_id":"network-2"}]`))
+	}))
+
+	got, err := client.ListNetworks(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListNetworks() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("ListNetworks() returned %d networks, want 2", len(got))
+	}
+}
+
+func TestIterConnectionsStopsEarly(t *testing.T) {
+	var requests int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"connections":[{"connection_id":"conn-1"},{"connection_id":"conn-2"}],"next_page_token":"more"}`))
+	}))
+
+	for conn, err := range client.IterConnections(context.Background(), nil) {
+		if err != nil {
+			t.Fatalf("IterConnections() error = %v", err)
+		}
+		if conn.ID == "conn-2" {
+			break
+		}
+	}
+	if requests != 1 {
+		t.Fatalf("requests = %d, want 1", requests)
+	}
+}
+
+func TestListDatastoresFilters(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if got := q.Get("network_id"); got != "network-1" {
+			t.Fatalf("network_id = %q, want %q", got, "network-1")
+		}
+		if got := q.Get("status"); got != "active" {
+			t.Fatalf("status = %q, want %q", got, "active")
+		}
+		if got := q.Get("performance_tier"); got != "enhanced" {
+			t.Fatalf("performance_tier = %q, want %q", got, "enhanced")
+		}
+		if q.Has("region") {
+			t.Fatalf("unexpected region filter %q", q.Get("region"))
+		}
+
+		// Ignore the filters to check the client filters the results.
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[
+			{"datastore_id":"ds-1","status":"active","config":{"network_id":"network-1","tier":{"performance_tier":"enhanced"}}},
+			{"datastore_id":"ds-2","status":"pending","config":{"network_id":"network-1","tier":{"performance_tier":"enhanced"}}},
+			{"datastore_id":"ds-3","status":"active","config":{"network_id":"network-1","tier":{"performance_tier":"standard"}}}
+		]`))
+	}))
+
+	got, err := client.ListDatastores(context.Background(), &ListDatastoresOptions{
+		NetworkID:       "network-1",
+		PerformanceTier: PerformanceTierEnhanced,
+		Status:          DatastoreStatusActive,
+	})
+	if err != nil {
+		t.Fatalf("ListDatastores() error = %v", err)
+	}
+	if len(got) != 1 || got[0].ID != "ds-1" {
+		t.Fatalf("ListDatastores() = %+v, want only ds-1", got)
+	}
+}
+
+func TestCreateBackup(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/backups" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var config BackupConfig
+		if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if config.DatastoreID != "ds-1" {
+			t.Fatalf("datastore_id = %q, want %q", config.DatastoreID, "ds-1")
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"backup_id":"backup-1","datastore_id":"ds-1","type":"manual","status":"pending"}`))
+	}))
+
+	got, err := client.CreateBackup(context.Background(), &BackupConfig{DatastoreID: "ds-1"})
+	if err != nil {
+		t.Fatalf("CreateBackup() error = %v", err)
+	}
+	if got.ID != "backup-1" || got.Type != BackupTypeManual || got.Status != BackupStatusPending {
+		t.Fatalf("CreateBackup() = %+v", got)
+	}
+}
+
+func TestListBackupsFilters(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if got := q.Get("datastore_id"); got != "ds-1" {
+			t.Fatalf("datastore_id = %q, want %q", got, "ds-1")
+		}
+		if got := q.Get("created_after"); got != "1000" {
+			t.Fatalf("created_after = %q, want %q", got, "1000")
+		}
+		if q.Has("created_before") {
+			t.Fatalf("unexpected created_before filter %q", q.Get("created_before"))
+		}
+
+		// Ignore the filters to check the client filters the results.
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"backups":[
+			{"backup_id":"backup-1","datastore_id":"ds-1","created_at":2000},
+			{"backup_id":"backup-2","datastore_id":"ds-2","created_at":2000},
+			{"backup_id":"backup-3","datastore_id":"ds-1","created_at":500}
+		]}`))
+	}))
+
+	got, err := client.ListBackups(context.Background(), &ListBackupsOptions{
+		DatastoreID:  "ds-1",
+		CreatedAfter: 1000,
+	})
+	if err != nil {
+		t.Fatalf("ListBackups() error = %v", err)
+	}
+	if len(got) != 1 || got[0].ID != "backup-1" {
+		t.Fatalf("ListBackups() = %+v, want only backup-1", got)
+	}
+}
+
+func TestWaitForNetworkFailsOnTerminalStatus(t *testing.T) {
+	var requests int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"network_id":"network-1","status":"failed","status_detail":"CIDR block overlaps"}`))
+	}))
+
+	_, err := client.WaitForNetwork(context.Background(), "network-1", NetworkStatusActive)
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("WaitForNetwork() error = %v, want StatusError", err)
+	}
+	if statusErr.Status != "failed" || statusErr.Detail != "CIDR block overlaps" {
+		t.Fatalf("WaitForNetwork() error = %+v", statusErr)
+	}
+	if requests != 1 {
+		t.Fatalf("requests = %d, want 1", requests)
+	}
+}
+
+func TestWaitForConnectionDeletedWhenNotFound(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	got, err := client.WaitForConnection(context.Background(), "conn-1", ConnectionStatusDeleted)
+	if err != nil {
+		t.Fatalf("WaitForConnection() error = %v", err)
+	}
+	if got.ID != "conn-1" || got.Status != ConnectionStatusDeleted {
+		t.Fatalf("WaitForConnection() = %+v, want deleted conn-1", got)
+	}
+}
+
+func TestWaitForDatastoreProvisionedNotFound(t *testing.T) {
+	var requests int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests > 1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"datastore_id":"ds-1","status":"pending"}`))
+	}), WithPollInterval(time.Millisecond, time.Millisecond))
+
+	_, err := client.WaitForDatastoreProvisioned(context.Background(), "ds-1")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("WaitForDatastoreProvisioned() error = %v, want not found", err)
+	}
+	if requests != 2 {
+		t.Fatalf("requests = %d, want 2", requests)
+	}
+}
+
+func TestWaitReportsLastStatusOnTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var progress []WaitProgress
+	clock := newFakeClock()
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"datastore_id":"ds-1","status":"pending"}`))
+	}), WithClock(clock), WithWaitLogger(func(ctx context.Context, p WaitProgress) {
+		progress = append(progress, p)
+		// Time out once provisioning has taken over an hour.
+		if p.Elapsed > time.Hour {
+			cancel()
+		}
+	}))
+
+	_, err := client.WaitForDatastore(ctx, "ds-1", DatastoreStatusActive)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("WaitForDatastore() error = %v, want canceled", err)
+	}
+	if !strings.Contains(err.Error(), "last status pending") {
+		t.Fatalf("WaitForDatastore() error = %q, want last status", err)
+	}
+	// Progress is logged on the first poll, then every 30 seconds.
+	if len(progress) < 100 || progress[0].Status != "pending" || progress[0].Kind != "datastore" {
+		t.Fatalf("progress = %d entries starting %+v, want periodic pending datastore", len(progress), progress[0])
+	}
+}
+
+func TestWaitPollsWithExponentialBackoff(t *testing.T) {
+	var polls int
+	clock := newFakeClock()
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := "pending"
+		if polls == 8 {
+			status = "active"
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"network_id":"network-1","status":%q}`, status)
+	}), WithClock(clock), WithPollInterval(time.Second, 10*time.Second))
+
+	got, err := client.WaitForNetwork(context.Background(), "network-1", NetworkStatusActive)
+	if err != nil {
+		t.Fatalf("WaitForNetwork() error = %v", err)
+	}
+	if got.Status != NetworkStatusActive {
+		t.Fatalf("WaitForNetwork() status = %q, want active", got.Status)
+	}
+
+	want := []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second,
+		10 * time.Second, 10 * time.Second, 10 * time.Second,
+	}
+	if !slices.Equal(clock.waits, want) {
+		t.Fatalf("waits = %v, want %v", clock.waits, want)
+	}
+}
+
+func TestWithNilClockUsesRealClock(t *testing.T) {
+	var polls int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := "pending"
+		if polls == 2 {
+			status = "active"
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"network_id":"network-1","status":%q}`, status)
+	}), WithClock(nil), WithPollInterval(time.Millisecond, time.Millisecond))
+
+	if _, err := client.WaitForNetwork(context.Background(), "network-1", NetworkStatusActive); err != nil {
+		t.Fatalf("WaitForNetwork() error = %v", err)
+	}
+}
+
+func TestNewClientInvalidPollInterval(t *testing.T) {
+	_, err := NewClient(WithAPIKey("test-key"), WithPollInterval(time.Minute, time.Second))
+	if err == nil {
+		t.Fatal("NewClient() error = nil, want invalid poll interval")
+	}
+}
+
+func TestNewClientWithCACertFile(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"network_id":"network-1"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatalf("write ca file: %v", err)
+	}
+
+	client, err := NewClient(
+		WithAPIKey("test-key"),
+		WithAPIHost(strings.TrimPrefix(srv.URL, "https://")),
+		WithCACertFile(caFile),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if _, err := client.GetNetwork(context.Background(), "network-1"); err != nil {
+		t.Fatalf("GetNetwork() error = %v", err)
+	}
+}
+
+func TestNewClientInvalidTransportOptions(t *testing.T) {
+	emptyFile := filepath.Join(t.TempDir(), "empty.pem")
+	if err := os.WriteFile(emptyFile, nil, 0o600); err != nil {
+		t.Fatalf("write ca file: %v", err)
+	}
+
+	tests := map[string]ClientOption{
+		"missing ca file":   WithCACertFile(filepath.Join(t.TempDir(), "missing.pem")),
+		"empty ca file":     WithCACertFile(emptyFile),
+		"proxy url":         WithProxyURL("proxy.internal:3128"),
+		"unparseable proxy": WithProxyURL("http://[::1"),
+	}
+	for name, opt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewClient(WithAPIKey("test-key"), opt); err == nil {
+				t.Fatal("NewClient() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestNewClientWithBaseURL(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/dfcloud/v1/networks/network-1" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"network_id":"network-1"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(
+		WithAPIKey("test-key"),
+		WithAPIHost(srv.URL+"/dfcloud/"),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if _, err := client.GetNetwork(context.Background(), "network-1"); err != nil {
+		t.Fatalf("GetNetwork() error = %v", err)
+	}
+}
+
+func TestParseBaseURL(t *testing.T) {
+	tests := []struct {
+		host    string
+		want    string
+		wantErr bool
+	}{
+		{host: "api.dragonflydb.cloud", want: "https://api.dragonflydb.cloud"},
+		{host: "localhost:8080", want: "https://localhost:8080"},
+		{host: "http://localhost:8080", want: "http://localhost:8080"},
+		{host: "https://gw.internal/dfcloud/", want: "https://gw.internal/dfcloud"},
+		{host: "ftp://gw.internal", wantErr: true},
+		{host: "http://", wantErr: true},
+		{host: "https://gw.internal/?a=b", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseBaseURL(tt.host)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseBaseURL(%q) error = nil, want error", tt.host)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseBaseURL(%q) error = %v", tt.host, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("parseBaseURL(%q) = %q, want %q", tt.host, got, tt.want)
+		}
+	}
+}
+
+func TestRequestLoggerRedactsSecrets(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"datastore_id":"ds-1","password":"secret-password","config":{"name":"one","dragonfly":{"acl_rules":["USER default ON >secret-acl +@ALL"]}}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	var logs []RequestLog
+	client, err := NewClient(
+		WithAPIKey("secret-key"),
+		WithAPIHost(srv.URL),
+		WithRequestLogger(func(ctx context.Context, log RequestLog) {
+			logs = append(logs, log)
+		}),
+		WithRequestBodyLogging(true),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	got, err := client.UpdateDatastore(context.Background(), "ds-1", &DatastoreConfig{
+		Name: "one",
+		Dragonfly: DatastoreDragonflyConfig{
+			AclRules: &AclRuleArray{"USER default ON >secret-acl +@ALL"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("UpdateDatastore() error = %v", err)
+	}
+	if got.Key != "secret-password" {
+		t.Fatalf("UpdateDatastore() Key = %q, want the unredacted password", got.Key)
+	}
+
+	if len(logs) != 1 {
+		t.Fatalf("got %d logs, want 1", len(logs))
+	}
+	log := logs[0]
+	if log.Method != http.MethodPut || log.StatusCode != http.StatusOK || log.Attempt != 1 {
+		t.Fatalf("log = %+v, want PUT with status 200 on attempt 1", log)
+	}
+	if got := log.RequestHeaders.Get("Authorization"); got != redacted {
+		t.Fatalf("Authorization header = %q, want %q", got, redacted)
+	}
+	for _, body := range []string{log.RequestBody, log.ResponseBody} {
+		if strings.Contains(body, "secret") {
+			t.Fatalf("log body contains a secret: %s", body)
+		}
+	}
+	if !strings.Contains(log.ResponseBody, `"name":"one"`) {
+		t.Fatalf("response body = %s, want non-secret fields to be kept", log.ResponseBody)
+	}
+}
+
+func TestRequestLoggerOmitsBodiesByDefault(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"datastore_id":"ds-1","config":{"name":"one"}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	var logs []RequestLog
+	client, err := NewClient(
+		WithAPIKey("secret-key"),
+		WithAPIHost(srv.URL),
+		WithRequestLogger(func(ctx context.Context, log RequestLog) {
+			logs = append(logs, log)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	got, err := client.UpdateDatastore(context.Background(), "ds-1", &DatastoreConfig{Name: "one"})
+	if err != nil {
+		t.Fatalf("UpdateDatastore() error = %v", err)
+	}
+	if got.Config.Name != "one" {
+		t.Fatalf("UpdateDatastore() name = %q, want %q", got.Config.Name, "one")
+	}
+
+	if len(logs) != 1 {
+		t.Fatalf("got %d logs, want 1", len(logs))
+	}
+	if logs[0].StatusCode != http.StatusOK {
+		t.Fatalf("log status = %d, want %d", logs[0].StatusCode, http.StatusOK)
+	}
+	if logs[0].RequestBody != "" || logs[0].ResponseBody != "" {
+		t.Fatalf("log = %+v, want no bodies", logs[0])
+	}
+}
+
+func TestGetCatalogOrDefaultFallsBack(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/catalog" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	catalog, fromAPI, err := client.GetCatalogOrDefault(context.Background())
+	if err != nil {
+		t.Fatalf("GetCatalogOrDefault() error = %v", err)
+	}
+	if fromAPI || len(catalog.Providers) == 0 {
+		t.Fatalf("GetCatalogOrDefault() = %+v, %v, want default catalog", catalog, fromAPI)
+	}
+}
+
+func TestGetCatalogOrDefaultFromAPI(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"providers":[{"provider":"aws","regions":[{"region":"us-east-1","availability_zones":["use1-az1"]}],"performance_tiers":[{"performance_tier":"dev","max_memory_bytes":[3000000000]}]}]}`))
+	}))
+
+	catalog, fromAPI, err := client.GetCatalogOrDefault(context.Background())
+	if err != nil {
+		t.Fatalf("GetCatalogOrDefault() error = %v", err)
+	}
+	if !fromAPI {
+		t.Fatal("GetCatalogOrDefault() used the default catalog, want the API catalog")
+	}
+	if tier := catalog.Tier(CloudProviderAWS, PerformanceTierDev); tier == nil || !slices.Equal(tier.MaxMemoryBytes, []uint64{3000000000}) {
+		t.Fatalf("Tier(aws, dev) = %+v", tier)
+	}
+}
+
+func TestDefaultCatalogMatchesPerformanceTiers(t *testing.T) {
+	seen := map[PerformanceTier]bool{}
+	for _, p := range DefaultCatalog().Providers {
+		for _, tier := range p.PerformanceTiers {
+			if !slices.Contains(PerformanceTiers, tier.PerformanceTier) {
+				t.Errorf("%s tier %q is missing from PerformanceTiers", p.Provider, tier.PerformanceTier)
+			}
+			if len(tier.MaxMemoryBytes) == 0 {
+				t.Errorf("%s tier %q has no memory sizes", p.Provider, tier.PerformanceTier)
+			}
+			seen[tier.PerformanceTier] = true
+		}
+	}
+	for _, tier := range PerformanceTiers {
+		if !seen[tier] {
+			t.Errorf("PerformanceTiers includes %q, which no provider supports", tier)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package sdk
+
+import "time"
+
+// Clock tells the time and waits, so tests can control how fast waiters and
+// retries advance.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After returns a channel that receives the time after d has elapsed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// realClock is the [Clock] backed by the time package.
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+type clockOption struct {
+	clock Clock
+}
+
+func (o clockOption) apply(opts *clientOptions) {
+	if o.clock != nil {
+		opts.clock = o.clock
+	}
+}
+
+// WithClock configures the clock the client uses to wait between status
+// polls and retries. Defaults to the system clock, which is also used if
+// clock is nil.
+func WithClock(clock Clock) ClientOption {
+	return clockOption{clock: clock}
+}
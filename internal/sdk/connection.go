@@ -1,5 +1,10 @@
 package sdk
 
+import (
+	"net/url"
+	"strings"
+)
+
 // NetworkStatus represents the current status of the connection.
 type ConnectionStatus string
 
@@ -28,9 +33,9 @@ const (
 
 // AzureConfig holds Azure-specific peering options.
 type AzureConfig struct {
-	ResourceGroup    string `json:"resource_group"`
-	TenantID         string `json:"tenant_id"`
-	AppObjectID      string `json:"app_object_id"`
+	ResourceGroup     string `json:"resource_group"`
+	TenantID          string `json:"tenant_id"`
+	AppObjectID       string `json:"app_object_id"`
 	UseRemoteGateways bool   `json:"use_remote_gateways"`
 }
 
@@ -69,3 +74,41 @@ type Connection struct {
 
 	Config *ConnectionConfig `json:"connection_config"`
 }
+
+// ListConnectionsOptions filters the connections returned by
+// [Client.ListConnections]. Empty fields don't filter.
+type ListConnectionsOptions struct {
+	// NamePrefix only includes connections whose name starts with the
+	// prefix.
+	NamePrefix string
+	// NetworkID only includes connections to the given network.
+	NetworkID string
+	// Status only includes connections with the given status.
+	Status ConnectionStatus
+}
+
+func (o *ListConnectionsOptions) values() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	setQuery(q, "name_prefix", o.NamePrefix)
+	setQuery(q, "network_id", o.NetworkID)
+	setQuery(q, "status", string(o.Status))
+	return q
+}
+
+// matches reports whether the connection passes the filters, in case the API
+// ignored any of them.
+func (o *ListConnectionsOptions) matches(conn *Connection) bool {
+	if o == nil {
+		return true
+	}
+	var config ConnectionConfig
+	if conn.Config != nil {
+		config = *conn.Config
+	}
+	return strings.HasPrefix(config.Name, o.NamePrefix) &&
+		matchFilter(o.NetworkID, config.NetworkID) &&
+		matchFilter(o.Status, conn.Status)
+}
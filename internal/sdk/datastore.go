@@ -1,5 +1,10 @@
 package sdk
 
+import (
+	"net/url"
+	"strings"
+)
+
 type CloudProvider string
 
 const (
@@ -31,12 +36,18 @@ const (
 	PerformanceTierDev      PerformanceTier = "dev"
 	PerformanceTierStandard PerformanceTier = "standard"
 	PerformanceTierEnhanced PerformanceTier = "enhanced"
+	PerformanceTierExtreme  PerformanceTier = "extreme"
+	// PerformanceTierBYOC is used by datastores provisioned into a BYOC
+	// account.
+	PerformanceTierBYOC PerformanceTier = "byoc"
 )
 
 var PerformanceTiers = []PerformanceTier{
 	PerformanceTierDev,
 	PerformanceTierStandard,
 	PerformanceTierEnhanced,
+	PerformanceTierExtreme,
+	PerformanceTierBYOC,
 }
 
 func PerformanceTiersString() []string {
@@ -90,7 +101,9 @@ type DatastoreConfig struct {
 	// Dragonfly contains the Dragonfly node configuration.
 	Dragonfly DatastoreDragonflyConfig `json:"dragonfly"`
 
-	BackupPolicy BackupPolicy `json:"backup_policy" mapstructure:"backup_policy"`
+	// BackupPolicy is the backup schedule. The API keeps the current policy
+	// if it is nil.
+	BackupPolicy *BackupPolicy `json:"backup_policy,omitempty" mapstructure:"backup_policy"`
 
 	Restore RestoreBackup `json:"restore"`
 
@@ -118,13 +131,16 @@ type RestoreBackup struct {
 	Loaded bool `json:"loaded"`
 }
 
+// BackupPolicy is the backup schedule of a datastore. The API keeps the
+// current value of fields that are nil, so switching schedules must set the
+// fields of the previous schedule to false or empty.
 type BackupPolicy struct {
 	Enabled   *bool `json:"enabled"`
 	Retention int   `json:"retention,omitempty"`
 	EveryHour *bool `json:"every_hour,omitempty"`
 	EveryDay  *bool `json:"every_day,omitempty"`
-	Hours     []int `json:"hours,omitempty"`
-	WeekDays  []int `json:"weekdays,omitempty"`
+	Hours     []int `json:"hours"`
+	WeekDays  []int `json:"weekdays"`
 }
 
 type DatastoreDashboard struct {
@@ -161,6 +177,10 @@ type Datastore struct {
 
 	Status DatastoreStatus `json:"status"`
 
+	// StatusDetail provides more information on the status of the
+	// datastore, such as why an update failed.
+	StatusDetail string `json:"status_detail,omitempty"`
+
 	CreatedAt int64 `json:"created_at" mapstructure:"created_at"`
 
 	// Key is the Dragonfly key to configure when connecting to your
@@ -175,3 +195,54 @@ type Datastore struct {
 
 	Config DatastoreConfig `json:"config"`
 }
+
+// ListDatastoresOptions filters the datastores returned by
+// [Client.ListDatastores]. Empty fields don't filter.
+type ListDatastoresOptions struct {
+	// NamePrefix only includes datastores whose name starts with the prefix.
+	NamePrefix string
+	// NetworkID only includes datastores in the given network.
+	NetworkID string
+	// Provider only includes datastores in the given cloud provider.
+	Provider CloudProvider
+	// Region only includes datastores in the given region.
+	Region string
+	// PerformanceTier only includes datastores in the given performance
+	// tier.
+	PerformanceTier PerformanceTier
+	// Status only includes datastores with the given status.
+	Status DatastoreStatus
+	// BYOCAccountID only includes datastores provisioned into the given
+	// BYOC account.
+	BYOCAccountID string
+}
+
+func (o *ListDatastoresOptions) values() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	setQuery(q, "name_prefix", o.NamePrefix)
+	setQuery(q, "network_id", o.NetworkID)
+	setQuery(q, "provider", string(o.Provider))
+	setQuery(q, "region", o.Region)
+	setQuery(q, "performance_tier", string(o.PerformanceTier))
+	setQuery(q, "status", string(o.Status))
+	setQuery(q, "byoc_account_id", o.BYOCAccountID)
+	return q
+}
+
+// matches reports whether the datastore passes the filters, in case the API
+// ignored any of them.
+func (o *ListDatastoresOptions) matches(ds *Datastore) bool {
+	if o == nil {
+		return true
+	}
+	return strings.HasPrefix(ds.Config.Name, o.NamePrefix) &&
+		matchFilter(o.NetworkID, ds.Config.NetworkID) &&
+		matchFilter(o.Provider, ds.Config.Location.Provider) &&
+		matchFilter(o.Region, ds.Config.Location.Region) &&
+		matchFilter(o.PerformanceTier, ds.Config.Tier.PerformanceTier) &&
+		matchFilter(o.Status, ds.Status) &&
+		matchFilter(o.BYOCAccountID, ds.Config.BYOC.AccountID)
+}
@@ -0,0 +1,150 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrNotFound is returned when the requested resource does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrUnauthorized is returned when the API key is missing or invalid.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden is returned when the API key is not permitted to perform
+	// the request.
+	ErrForbidden = errors.New("forbidden")
+	// ErrConflict is returned when the request conflicts with the current
+	// state of the resource, such as updating a datastore that is already
+	// being updated.
+	ErrConflict = errors.New("conflict")
+	// ErrValidation is returned when the API rejects the request body.
+	ErrValidation = errors.New("validation failed")
+	// ErrRateLimited is returned when the request was throttled and retries
+	// were exhausted.
+	ErrRateLimited = errors.New("rate limited")
+)
+
+// errorResponse is the body returned by the API for failed requests.
+type errorResponse struct {
+	Error     string        `json:"error"`
+	Details   []ErrorDetail `json:"details,omitempty"`
+	RequestID string        `json:"request_id,omitempty"`
+}
+
+// ErrorDetail describes a problem with a single field of the request.
+type ErrorDetail struct {
+	// Field is the path of the offending field, such as
+	// "tier.max_memory_bytes".
+	Field string `json:"field"`
+	// Reason describes why the field was rejected.
+	Reason string `json:"reason"`
+}
+
+// Path splits Field into its segments. Fields may use dot notation with list
+// indexes in brackets, such as "location.availability_zones[1]", or a JSON
+// pointer, such as "/location/availability_zones/1". List indexes are
+// returned as their own segment.
+func (d ErrorDetail) Path() []string {
+	field := strings.TrimPrefix(d.Field, "/")
+	if field == "" {
+		return nil
+	}
+
+	sep := "."
+	if strings.HasPrefix(d.Field, "/") {
+		sep = "/"
+	}
+
+	var segments []string
+	for _, s := range strings.Split(field, sep) {
+		// Split "availability_zones[1]" into "availability_zones" and "1".
+		for s != "" {
+			i := strings.IndexByte(s, '[')
+			if i < 0 {
+				segments = append(segments, s)
+				break
+			}
+			if i > 0 {
+				segments = append(segments, s[:i])
+			}
+			j := strings.IndexByte(s[i:], ']')
+			if j < 0 {
+				segments = append(segments, s[i+1:])
+				break
+			}
+			segments = append(segments, s[i+1:i+j])
+			s = s[i+j+1:]
+		}
+	}
+	return segments
+}
+
+// APIError is returned when the API responds with a non-200 status.
+//
+// Use [errors.Is] with the sentinel errors, such as [ErrNotFound] or
+// [ErrConflict], to check the kind of failure, or [errors.As] to access the
+// details.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Message is the error message returned by the API, if any.
+	Message string
+	// Details contains field-level errors returned by the API, if any.
+	Details []ErrorDetail
+	// RequestID identifies the request in the API logs. Include it when
+	// contacting support.
+	RequestID string
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "bad status: %d", e.StatusCode)
+	if e.Message != "" {
+		fmt.Fprintf(&b, ": %s", e.Message)
+	}
+	for _, d := range e.Details {
+		if d.Field != "" {
+			fmt.Fprintf(&b, "; %s: %s", d.Field, d.Reason)
+		} else {
+			fmt.Fprintf(&b, "; %s", d.Reason)
+		}
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, " (request id: %s)", e.RequestID)
+	}
+	return b.String()
+}
+
+// FieldErrors returns the details that refer to a specific request field.
+func (e *APIError) FieldErrors() []ErrorDetail {
+	var details []ErrorDetail
+	for _, d := range e.Details {
+		if d.Field != "" {
+			details = append(details, d)
+		}
+	}
+	return details
+}
+
+// Is reports whether the error matches one of the sentinel errors based on
+// its status code.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest ||
+			e.StatusCode == http.StatusUnprocessableEntity
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
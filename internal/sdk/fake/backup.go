@@ -0,0 +1,72 @@
+package fake
+
+import (
+	"net/http"
+
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+)
+
+func (s *Server) listBackups(w http.ResponseWriter, r *http.Request) {
+	var backups []*dfcloud.Backup
+	for _, b := range sorted(s.state.Backups, func(b *backup) int { return b.Seq }) {
+		backups = append(backups, &b.Backup)
+	}
+
+	items, next, ok := page(w, r, backups)
+	if !ok {
+		return
+	}
+	writeJSON(w, map[string]any{
+		"backups":         items,
+		"next_page_token": next,
+	})
+}
+
+func (s *Server) getBackup(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.state.Backups[r.PathValue("id")]
+	if !ok {
+		notFound(w, "backup", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, &b.Backup)
+}
+
+func (s *Server) createBackup(w http.ResponseWriter, r *http.Request) {
+	var config dfcloud.BackupConfig
+	if !decode(w, r, &config) {
+		return
+	}
+	if details := s.validateBackup(&config); len(details) > 0 {
+		writeError(w, http.StatusBadRequest, "invalid backup config", details)
+		return
+	}
+
+	now := s.now()
+	seq := s.state.NextID
+	id := s.state.newID("bkp")
+	b := &backup{
+		Backup: dfcloud.Backup{
+			ID:          id,
+			DatastoreID: config.DatastoreID,
+			Type:        dfcloud.BackupTypeManual,
+			Status:      dfcloud.BackupStatusPending,
+			CreatedAt:   now.Unix(),
+		},
+		tracked: tracked{Seq: seq, Since: now},
+	}
+	s.state.Backups[id] = b
+
+	writeJSON(w, &b.Backup)
+}
+
+func (s *Server) deleteBackup(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.state.Backups[r.PathValue("id")]
+	if !ok {
+		notFound(w, "backup", r.PathValue("id"))
+		return
+	}
+	if b.Status != dfcloud.BackupStatusDeleting {
+		b.setStatus(dfcloud.BackupStatusDeleting, s.now())
+	}
+	writeJSON(w, &b.Backup)
+}
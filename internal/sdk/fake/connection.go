@@ -0,0 +1,70 @@
+package fake
+
+import (
+	"net/http"
+
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+)
+
+func (s *Server) listConnections(w http.ResponseWriter, r *http.Request) {
+	var connections []*dfcloud.Connection
+	for _, conn := range sorted(s.state.Connections, func(conn *connection) int { return conn.Seq }) {
+		connections = append(connections, &conn.Connection)
+	}
+
+	items, next, ok := page(w, r, connections)
+	if !ok {
+		return
+	}
+	writeJSON(w, map[string]any{
+		"connections":     items,
+		"next_page_token": next,
+	})
+}
+
+func (s *Server) getConnection(w http.ResponseWriter, r *http.Request) {
+	conn, ok := s.state.Connections[r.PathValue("id")]
+	if !ok {
+		notFound(w, "connection", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, &conn.Connection)
+}
+
+func (s *Server) createConnection(w http.ResponseWriter, r *http.Request) {
+	var config dfcloud.ConnectionConfig
+	if !decode(w, r, &config) {
+		return
+	}
+	if details := s.validateConnection(&config); len(details) > 0 {
+		writeError(w, http.StatusBadRequest, "invalid connection config", details)
+		return
+	}
+
+	now := s.now()
+	seq := s.state.NextID
+	id := s.state.newID("conn")
+	conn := &connection{
+		Connection: dfcloud.Connection{
+			ID:     id,
+			Status: dfcloud.ConnectionStatusPending,
+			Config: &config,
+		},
+		tracked: tracked{Seq: seq, Since: now},
+	}
+	s.state.Connections[id] = conn
+
+	writeJSON(w, &conn.Connection)
+}
+
+func (s *Server) deleteConnection(w http.ResponseWriter, r *http.Request) {
+	conn, ok := s.state.Connections[r.PathValue("id")]
+	if !ok {
+		notFound(w, "connection", r.PathValue("id"))
+		return
+	}
+	if conn.Status != dfcloud.ConnectionStatusDeleting {
+		conn.setStatus(dfcloud.ConnectionStatusDeleting, s.now())
+	}
+	writeJSON(w, &conn.Connection)
+}
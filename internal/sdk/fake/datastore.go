@@ -0,0 +1,141 @@
+package fake
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+)
+
+func (s *Server) listDatastores(w http.ResponseWriter, r *http.Request) {
+	var datastores []*dfcloud.Datastore
+	for _, ds := range sorted(s.state.Datastores, func(ds *datastore) int { return ds.Seq }) {
+		datastores = append(datastores, &ds.Datastore)
+	}
+
+	items, next, ok := page(w, r, datastores)
+	if !ok {
+		return
+	}
+	writeJSON(w, map[string]any{
+		"datastores":      items,
+		"next_page_token": next,
+	})
+}
+
+func (s *Server) getDatastore(w http.ResponseWriter, r *http.Request) {
+	ds, ok := s.state.Datastores[r.PathValue("id")]
+	if !ok {
+		notFound(w, "datastore", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, &ds.Datastore)
+}
+
+func (s *Server) createDatastore(w http.ResponseWriter, r *http.Request) {
+	var config dfcloud.DatastoreConfig
+	if !decode(w, r, &config) {
+		return
+	}
+	if details := s.validateDatastore(&config); len(details) > 0 {
+		writeError(w, http.StatusBadRequest, "invalid datastore config", details)
+		return
+	}
+
+	now := s.now()
+	seq := s.state.NextID
+	id := s.state.newID("dst")
+	ds := &datastore{
+		Datastore: dfcloud.Datastore{
+			ID:        id,
+			Status:    dfcloud.DatastoreStatusPending,
+			CreatedAt: now.Unix(),
+			Addr:      fmt.Sprintf("%s.dragonflydb.cloud:6385", id),
+			Dashboard: &dfcloud.DatastoreDashboard{
+				URL: "https://grafana.dragonflydb.cloud/d/" + id,
+			},
+			Config: config,
+		},
+		tracked: tracked{Seq: seq, Since: now},
+	}
+	if !config.DisablePasskey {
+		ds.Key = "password-" + id
+	}
+	s.state.Datastores[id] = ds
+
+	writeJSON(w, &ds.Datastore)
+}
+
+func (s *Server) updateDatastore(w http.ResponseWriter, r *http.Request) {
+	ds, ok := s.state.Datastores[r.PathValue("id")]
+	if !ok {
+		notFound(w, "datastore", r.PathValue("id"))
+		return
+	}
+
+	var config dfcloud.DatastoreConfig
+	if !decode(w, r, &config) {
+		return
+	}
+	if ds.Status != dfcloud.DatastoreStatusActive {
+		writeError(w, http.StatusConflict, fmt.Sprintf("datastore is %s", ds.Status), nil)
+		return
+	}
+	config.BackupPolicy = mergeBackupPolicy(ds.Config.BackupPolicy, config.BackupPolicy)
+	if details := s.validateDatastore(&config); len(details) > 0 {
+		writeError(w, http.StatusBadRequest, "invalid datastore config", details)
+		return
+	}
+
+	status := dfcloud.DatastoreStatusUpdating
+	if config.Restore.BackupId != "" {
+		status = dfcloud.DatastoreStatusRestoring
+		config.Restore.Loaded = false
+	} else {
+		// The API keeps the restored backup until another is requested.
+		config.Restore = ds.Config.Restore
+	}
+	ds.Config = config
+	ds.setStatus(status, s.now())
+	if config.DisablePasskey {
+		ds.Key = ""
+	} else if ds.Key == "" {
+		ds.Key = "password-" + ds.ID
+	}
+
+	writeJSON(w, &ds.Datastore)
+}
+
+func (s *Server) deleteDatastore(w http.ResponseWriter, r *http.Request) {
+	ds, ok := s.state.Datastores[r.PathValue("id")]
+	if !ok {
+		notFound(w, "datastore", r.PathValue("id"))
+		return
+	}
+	if ds.Status != dfcloud.DatastoreStatusDeleting {
+		ds.setStatus(dfcloud.DatastoreStatusDeleting, s.now())
+	}
+	writeJSON(w, &ds.Datastore)
+}
+
+// mergeBackupPolicy returns the backup policy after an update, which keeps
+// the current value of the fields the update omits like the API does.
+func mergeBackupPolicy(current, update *dfcloud.BackupPolicy) *dfcloud.BackupPolicy {
+	if update == nil || current == nil {
+		return cmp.Or(update, current)
+	}
+
+	merged := *update
+	merged.Enabled = cmp.Or(merged.Enabled, current.Enabled)
+	merged.Retention = cmp.Or(merged.Retention, current.Retention)
+	merged.EveryHour = cmp.Or(merged.EveryHour, current.EveryHour)
+	merged.EveryDay = cmp.Or(merged.EveryDay, current.EveryDay)
+	if merged.Hours == nil {
+		merged.Hours = current.Hours
+	}
+	if merged.WeekDays == nil {
+		merged.WeekDays = current.WeekDays
+	}
+	return &merged
+}
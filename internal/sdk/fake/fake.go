@@ -0,0 +1,489 @@
+// Package fake implements an in-memory fake of the Dragonfly Cloud API, for
+// testing the SDK and provider without real cloud resources.
+//
+// The fake serves the datastore, network, connection, backup and catalog
+// endpoints the SDK uses. Resources move through the same statuses as the
+// real API: new resources are pending until they are provisioned, and
+// deleted resources are deleting until they are removed, after which they
+// are not found.
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+)
+
+// Server is an in-memory fake of the Dragonfly Cloud API. It implements
+// [http.Handler], so can be served with [net/http/httptest].
+type Server struct {
+	mu     sync.Mutex
+	state  *state
+	faults []*Fault
+
+	// requests counts requests to generate request IDs.
+	requests int
+
+	apiKey  string
+	delay   time.Duration
+	now     func() time.Time
+	catalog *dfcloud.Catalog
+
+	mux *http.ServeMux
+}
+
+// Option configures a [Server].
+type Option func(*Server)
+
+// WithAPIKey requires requests to authenticate with the given API key. By
+// default any key is accepted.
+func WithAPIKey(key string) Option {
+	return func(s *Server) {
+		s.apiKey = key
+	}
+}
+
+// WithDelay sets how long resources take to be provisioned, updated or
+// deleted. Defaults to 0, so resources transition on the next request.
+func WithDelay(delay time.Duration) Option {
+	return func(s *Server) {
+		s.delay = delay
+	}
+}
+
+// WithClock sets the function used to tell the time. Defaults to
+// [time.Now].
+func WithClock(now func() time.Time) Option {
+	return func(s *Server) {
+		s.now = now
+	}
+}
+
+// WithCatalog sets the catalog the server serves and validates datastores
+// against. Defaults to [dfcloud.DefaultCatalog].
+func WithCatalog(catalog *dfcloud.Catalog) Option {
+	return func(s *Server) {
+		s.catalog = catalog
+	}
+}
+
+// NewServer returns a fake API with no resources.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		state:   newState(),
+		now:     time.Now,
+		catalog: dfcloud.DefaultCatalog(),
+	}
+	for _, o := range opts {
+		o(s)
+	}
+
+	s.mux = http.NewServeMux()
+	s.mux.HandleFunc("GET /v1/datastores", s.listDatastores)
+	s.mux.HandleFunc("POST /v1/datastores", s.idempotent(s.createDatastore))
+	s.mux.HandleFunc("GET /v1/datastores/{id}", s.getDatastore)
+	s.mux.HandleFunc("PUT /v1/datastores/{id}", s.updateDatastore)
+	s.mux.HandleFunc("DELETE /v1/datastores/{id}", s.deleteDatastore)
+
+	s.mux.HandleFunc("GET /v1/networks", s.listNetworks)
+	s.mux.HandleFunc("POST /v1/networks", s.idempotent(s.createNetwork))
+	s.mux.HandleFunc("GET /v1/networks/{id}", s.getNetwork)
+	s.mux.HandleFunc("PUT /v1/networks/{id}", s.updateNetwork)
+	s.mux.HandleFunc("DELETE /v1/networks/{id}", s.deleteNetwork)
+
+	s.mux.HandleFunc("GET /v1/connections", s.listConnections)
+	s.mux.HandleFunc("POST /v1/connections", s.idempotent(s.createConnection))
+	s.mux.HandleFunc("GET /v1/connections/{id}", s.getConnection)
+	s.mux.HandleFunc("DELETE /v1/connections/{id}", s.deleteConnection)
+
+	s.mux.HandleFunc("GET /v1/backups", s.listBackups)
+	s.mux.HandleFunc("POST /v1/backups", s.idempotent(s.createBackup))
+	s.mux.HandleFunc("GET /v1/backups/{id}", s.getBackup)
+	s.mux.HandleFunc("DELETE /v1/backups/{id}", s.deleteBackup)
+
+	s.mux.HandleFunc("GET /v1/catalog", s.getCatalog)
+
+	return s
+}
+
+// Fault is an error response returned instead of handling matching
+// requests. See [Server.InjectFault].
+type Fault struct {
+	// Method matches the request method. Empty matches any method.
+	Method string
+	// Path matches the request path, such as "/v1/networks". Empty matches
+	// any path.
+	Path string
+
+	// StatusCode is the status of the error response.
+	StatusCode int
+	// Message is the error message of the response.
+	Message string
+	// Details are the field errors of the response.
+	Details []dfcloud.ErrorDetail
+
+	// Times is how many matching requests fail. Zero fails one request.
+	Times int
+}
+
+// InjectFault makes requests matching the fault fail with its error
+// response, without being handled.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if f.Times <= 0 {
+		f.Times = 1
+	}
+	s.faults = append(s.faults, &f)
+}
+
+// Save writes the resources of the server as JSON, to be restored with
+// [Server.Load].
+func (s *Server) Save(w io.Writer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s.state)
+}
+
+// Load replaces the resources of the server with those written by
+// [Server.Save]. Resources in a transitional status complete their
+// transition as if the server had kept running.
+func (s *Server) Load(r io.Reader) error {
+	// Decode into an empty state, so resources missing from r are empty
+	// rather than nil.
+	st := newState()
+	if err := json.NewDecoder(r).Decode(st); err != nil {
+		return fmt.Errorf("decode state: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.state = st
+	return nil
+}
+
+// Datastore returns a copy of the datastore with the given ID.
+func (s *Server) Datastore(id string) (*dfcloud.Datastore, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.advance()
+	ds, ok := s.state.Datastores[id]
+	if !ok {
+		return nil, false
+	}
+	return clone(&ds.Datastore), true
+}
+
+// Network returns a copy of the network with the given ID.
+func (s *Server) Network(id string) (*dfcloud.Network, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.advance()
+	n, ok := s.state.Networks[id]
+	if !ok {
+		return nil, false
+	}
+	return clone(&n.Network), true
+}
+
+// Connection returns a copy of the connection with the given ID.
+func (s *Server) Connection(id string) (*dfcloud.Connection, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.advance()
+	conn, ok := s.state.Connections[id]
+	if !ok {
+		return nil, false
+	}
+	return clone(&conn.Connection), true
+}
+
+// Backup returns a copy of the backup with the given ID.
+func (s *Server) Backup(id string) (*dfcloud.Backup, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.advance()
+	b, ok := s.state.Backups[id]
+	if !ok {
+		return nil, false
+	}
+	return clone(&b.Backup), true
+}
+
+// FailNetwork moves the network to the failed status with the given detail,
+// as if it failed to provision.
+func (s *Server) FailNetwork(id string, detail string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n, ok := s.state.Networks[id]
+	if !ok {
+		return false
+	}
+	n.setStatus(dfcloud.NetworkStatusFailed, s.now())
+	n.StatusDetail = detail
+	return true
+}
+
+// FailConnection moves the connection to the given failure status, such as
+// [dfcloud.ConnectionStatusFailed], with the given detail.
+func (s *Server) FailConnection(id string, status dfcloud.ConnectionStatus, detail string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	conn, ok := s.state.Connections[id]
+	if !ok {
+		return false
+	}
+	conn.setStatus(status, s.now())
+	conn.StatusDetail = detail
+	return true
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+	w.Header().Set("X-Request-Id", fmt.Sprintf("req_%d", s.requests))
+
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "invalid api key", nil)
+		return
+	}
+	if f := s.fault(r); f != nil {
+		writeError(w, f.StatusCode, f.Message, f.Details)
+		return
+	}
+
+	s.advance()
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	key, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || key == "" {
+		return false
+	}
+	return s.apiKey == "" || key == s.apiKey
+}
+
+// fault returns the first fault matching the request, if any.
+func (s *Server) fault(r *http.Request) *Fault {
+	for i, f := range s.faults {
+		if (f.Method != "" && f.Method != r.Method) || (f.Path != "" && f.Path != r.URL.Path) {
+			continue
+		}
+		f.Times--
+		if f.Times == 0 {
+			s.faults = slices.Delete(s.faults, i, i+1)
+		}
+		return f
+	}
+	return nil
+}
+
+// advance completes the transitions of resources that have been in a
+// transitional status for longer than the delay.
+func (s *Server) advance() {
+	now := s.now()
+	due := func(since time.Time) bool {
+		return !now.Before(since.Add(s.delay))
+	}
+
+	for id, ds := range s.state.Datastores {
+		if !due(ds.Since) {
+			continue
+		}
+		switch ds.Status {
+		case dfcloud.DatastoreStatusPending, dfcloud.DatastoreStatusUpdating, dfcloud.DatastoreStatusRestoring:
+			if ds.Config.Restore.BackupId != "" {
+				ds.Config.Restore.Loaded = true
+			}
+			ds.setStatus(dfcloud.DatastoreStatusActive, now)
+		case dfcloud.DatastoreStatusDeleting:
+			delete(s.state.Datastores, id)
+		}
+	}
+
+	for id, n := range s.state.Networks {
+		if !due(n.Since) {
+			continue
+		}
+		switch n.Status {
+		case dfcloud.NetworkStatusPending:
+			n.VPC = &dfcloud.NetworkVPC{
+				ResourceID: "vpc-" + id,
+				AccountID:  "dragonfly-" + string(n.Location.Provider),
+			}
+			n.setStatus(dfcloud.NetworkStatusActive, now)
+		case dfcloud.NetworkStatusDeleting:
+			delete(s.state.Networks, id)
+		}
+	}
+
+	for id, conn := range s.state.Connections {
+		if !due(conn.Since) {
+			continue
+		}
+		switch conn.Status {
+		case dfcloud.ConnectionStatusPending:
+			// Connections wait for the peer to accept them, which the fake
+			// never does.
+			conn.PeerConnectionID = "pcx-" + id
+			conn.setStatus(dfcloud.ConnectionStatusInactive, now)
+		case dfcloud.ConnectionStatusDeleting:
+			delete(s.state.Connections, id)
+		}
+	}
+
+	for id, b := range s.state.Backups {
+		if !due(b.Since) {
+			continue
+		}
+		switch b.Status {
+		case dfcloud.BackupStatusPending:
+			if ds, ok := s.state.Datastores[b.DatastoreID]; ok {
+				// Pretend the datastore is a tenth full.
+				b.SizeBytes = int64(ds.Config.Tier.Memory / 10)
+			}
+			b.setStatus(dfcloud.BackupStatusActive, now)
+		case dfcloud.BackupStatusDeleting:
+			delete(s.state.Backups, id)
+		}
+	}
+}
+
+func (s *Server) getCatalog(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, s.catalog)
+}
+
+// idempotent wraps a create handler to replay the response of a previous
+// request with the same Idempotency-Key.
+func (s *Server) idempotent(create http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("Idempotency-Key")
+		if key == "" {
+			create(w, r)
+			return
+		}
+		if body, ok := s.state.IdempotencyKeys[key]; ok {
+			writeJSON(w, body)
+			return
+		}
+
+		rec := &recorder{ResponseWriter: w, status: http.StatusOK}
+		create(rec, r)
+		if rec.status == http.StatusOK {
+			s.state.IdempotencyKeys[key] = rec.body
+		}
+	}
+}
+
+// recorder records the status and body of a response.
+type recorder struct {
+	http.ResponseWriter
+	status int
+	body   json.RawMessage
+}
+
+func (r *recorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	r.body = append(r.body, b...)
+	return r.ResponseWriter.Write(b)
+}
+
+// decode decodes the request body into v, writing an error response if it
+// is invalid.
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err), nil)
+		return false
+	}
+	return true
+}
+
+// page returns the items of the page requested with page_size and
+// page_token, and the token of the next page.
+func page[T any](w http.ResponseWriter, r *http.Request, items []T) ([]T, string, bool) {
+	offset, size := 0, len(items)
+	if v := r.URL.Query().Get("page_token"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 || n > len(items) {
+			writeError(w, http.StatusBadRequest, "invalid page_token", nil)
+			return nil, "", false
+		}
+		offset = n
+	}
+	if v := r.URL.Query().Get("page_size"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			writeError(w, http.StatusBadRequest, "invalid page_size", nil)
+			return nil, "", false
+		}
+		size = n
+	}
+
+	end := min(offset+size, len(items))
+	next := ""
+	if end < len(items) {
+		next = strconv.Itoa(end)
+	}
+	return items[offset:end], next, true
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string, details []dfcloud.ErrorDetail) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(struct {
+		Error   string                `json:"error"`
+		Details []dfcloud.ErrorDetail `json:"details,omitempty"`
+	}{
+		Error:   message,
+		Details: details,
+	})
+}
+
+func notFound(w http.ResponseWriter, kind string, id string) {
+	writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", kind, id), nil)
+}
+
+// clone returns a deep copy of v, so callers can't modify the server state.
+func clone[T any](v *T) *T {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	var c T
+	if err := json.Unmarshal(b, &c); err != nil {
+		panic(err)
+	}
+	return &c
+}
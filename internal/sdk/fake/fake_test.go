@@ -0,0 +1,375 @@
+package fake
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+)
+
+func newTestClient(t *testing.T, s *Server) *dfcloud.Client {
+	t.Helper()
+
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+
+	client, err := dfcloud.NewClient(
+		dfcloud.WithAPIKey("test-key"),
+		dfcloud.WithAPIHost(srv.URL),
+		dfcloud.WithPollInterval(time.Millisecond, time.Millisecond),
+		dfcloud.WithRetryPolicy(dfcloud.RetryPolicy{MaxRetries: 0}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return client
+}
+
+func TestNetworkLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, NewServer())
+
+	created, err := client.CreateNetwork(ctx, &dfcloud.NetworkConfig{
+		Name:      "net",
+		Location:  dfcloud.NetworkLocation{Provider: dfcloud.CloudProviderAWS, Region: "eu-west-1"},
+		CIDRBlock: "192.168.0.0/16",
+	})
+	if err != nil {
+		t.Fatalf("CreateNetwork() error = %v", err)
+	}
+	if created.Status != dfcloud.NetworkStatusPending {
+		t.Fatalf("CreateNetwork() status = %q, want pending", created.Status)
+	}
+
+	active, err := client.WaitForNetwork(ctx, created.ID, dfcloud.NetworkStatusActive)
+	if err != nil {
+		t.Fatalf("WaitForNetwork() error = %v", err)
+	}
+	if active.VPC == nil || active.VPC.ResourceID == "" {
+		t.Fatalf("WaitForNetwork() VPC = %+v, want provisioned VPC", active.VPC)
+	}
+
+	if err := client.DeleteNetwork(ctx, created.ID); err != nil {
+		t.Fatalf("DeleteNetwork() error = %v", err)
+	}
+	if _, err := client.WaitForNetwork(ctx, created.ID, dfcloud.NetworkStatusDeleted); err != nil {
+		t.Fatalf("WaitForNetwork() error = %v", err)
+	}
+	if _, err := client.GetNetwork(ctx, created.ID); !errors.Is(err, dfcloud.ErrNotFound) {
+		t.Fatalf("GetNetwork() error = %v, want not found", err)
+	}
+}
+
+func TestDatastoreTransitionsAfterDelay(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewServer(WithDelay(time.Minute), WithClock(func() time.Time { return now }))
+	client := newTestClient(t, s)
+
+	created, err := client.CreateDatastore(ctx, &dfcloud.DatastoreConfig{
+		Name:     "cache",
+		Location: dfcloud.DatastoreLocation{Provider: dfcloud.CloudProviderGCP, Region: "us-central1"},
+		Tier:     dfcloud.DatastoreTier{Memory: 3000000000, PerformanceTier: dfcloud.PerformanceTierDev},
+	})
+	if err != nil {
+		t.Fatalf("CreateDatastore() error = %v", err)
+	}
+
+	now = now.Add(30 * time.Second)
+	if ds, _ := s.Datastore(created.ID); ds.Status != dfcloud.DatastoreStatusPending {
+		t.Fatalf("status after 30s = %q, want pending", ds.Status)
+	}
+	now = now.Add(30 * time.Second)
+	if ds, _ := s.Datastore(created.ID); ds.Status != dfcloud.DatastoreStatusActive {
+		t.Fatalf("status after 60s = %q, want active", ds.Status)
+	}
+
+	// Updates are rejected until the update in progress completes.
+	config := created.Config
+	replicas := 1
+	config.Tier.Replicas = &replicas
+	if _, err := client.UpdateDatastore(ctx, created.ID, &config); err != nil {
+		t.Fatalf("UpdateDatastore() error = %v", err)
+	}
+	if _, err := client.UpdateDatastore(ctx, created.ID, &config); !errors.Is(err, dfcloud.ErrConflict) {
+		t.Fatalf("UpdateDatastore() error = %v, want conflict", err)
+	}
+}
+
+func TestCreateValidatesConfig(t *testing.T) {
+	client := newTestClient(t, NewServer())
+
+	_, err := client.CreateNetwork(context.Background(), &dfcloud.NetworkConfig{
+		Name:      "net",
+		Location:  dfcloud.NetworkLocation{Provider: "oracle", Region: "eu-west-1"},
+		CIDRBlock: "not-a-cidr",
+	})
+	var apiErr *dfcloud.APIError
+	if !errors.As(err, &apiErr) || !errors.Is(err, dfcloud.ErrValidation) {
+		t.Fatalf("CreateNetwork() error = %v, want validation error", err)
+	}
+	if len(apiErr.FieldErrors()) != 2 {
+		t.Fatalf("FieldErrors() = %+v, want provider and cidr_block", apiErr.FieldErrors())
+	}
+}
+
+func TestCreateReplaysIdempotencyKey(t *testing.T) {
+	ctx := dfcloud.WithIdempotencyKey(context.Background(), "key-1")
+	s := NewServer()
+	client := newTestClient(t, s)
+
+	config := &dfcloud.NetworkConfig{
+		Name:      "net",
+		Location:  dfcloud.NetworkLocation{Provider: dfcloud.CloudProviderAWS, Region: "eu-west-1"},
+		CIDRBlock: "10.0.0.0/16",
+	}
+	first, err := client.CreateNetwork(ctx, config)
+	if err != nil {
+		t.Fatalf("CreateNetwork() error = %v", err)
+	}
+	second, err := client.CreateNetwork(ctx, config)
+	if err != nil {
+		t.Fatalf("CreateNetwork() error = %v", err)
+	}
+	if first.ID != second.ID {
+		t.Fatalf("CreateNetwork() IDs = %q and %q, want the same", first.ID, second.ID)
+	}
+
+	networks, err := client.ListNetworks(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListNetworks() error = %v", err)
+	}
+	if len(networks) != 1 {
+		t.Fatalf("ListNetworks() returned %d networks, want 1", len(networks))
+	}
+}
+
+func TestInjectFault(t *testing.T) {
+	s := NewServer()
+	client := newTestClient(t, s)
+
+	s.InjectFault(Fault{
+		Method:     http.MethodGet,
+		Path:       "/v1/datastores",
+		StatusCode: http.StatusForbidden,
+		Message:    "forbidden",
+	})
+
+	if _, err := client.ListDatastores(context.Background(), nil); !errors.Is(err, dfcloud.ErrForbidden) {
+		t.Fatalf("ListDatastores() error = %v, want forbidden", err)
+	}
+	if _, err := client.ListDatastores(context.Background(), nil); err != nil {
+		t.Fatalf("ListDatastores() error = %v after fault", err)
+	}
+}
+
+func TestListPaginates(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+	client, err := dfcloud.NewClient(
+		dfcloud.WithAPIKey("test-key"),
+		dfcloud.WithAPIHost(srv.URL),
+		dfcloud.WithPageSize(2),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		_, err := client.CreateNetwork(ctx, &dfcloud.NetworkConfig{
+			Name:      name,
+			Location:  dfcloud.NetworkLocation{Provider: dfcloud.CloudProviderAWS, Region: "eu-west-1"},
+			CIDRBlock: "10.0.0.0/16",
+		})
+		if err != nil {
+			t.Fatalf("CreateNetwork() error = %v", err)
+		}
+	}
+
+	networks, err := client.ListNetworks(ctx, nil)
+	if err != nil {
+		t.Fatalf("ListNetworks() error = %v", err)
+	}
+	var names []string
+	for _, n := range networks {
+		names = append(names, n.Name)
+	}
+	if got := len(names); got != 5 || names[0] != "a" || names[4] != "e" {
+		t.Fatalf("ListNetworks() names = %v, want a to e in order", names)
+	}
+}
+
+func TestRejectsWrongAPIKey(t *testing.T) {
+	client := newTestClient(t, NewServer(WithAPIKey("other-key")))
+
+	if _, err := client.GetNetwork(context.Background(), "net_1"); !errors.Is(err, dfcloud.ErrUnauthorized) {
+		t.Fatalf("GetNetwork() error = %v, want unauthorized", err)
+	}
+}
+
+func TestFailedConnection(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	client := newTestClient(t, s)
+
+	n, err := client.CreateNetwork(ctx, &dfcloud.NetworkConfig{
+		Name:      "net",
+		Location:  dfcloud.NetworkLocation{Provider: dfcloud.CloudProviderAWS, Region: "eu-west-1"},
+		CIDRBlock: "10.0.0.0/16",
+	})
+	if err != nil {
+		t.Fatalf("CreateNetwork() error = %v", err)
+	}
+	if _, err := client.WaitForNetwork(ctx, n.ID, dfcloud.NetworkStatusActive); err != nil {
+		t.Fatalf("WaitForNetwork() error = %v", err)
+	}
+
+	conn, err := client.CreateConnection(ctx, &dfcloud.ConnectionConfig{
+		Name:      "conn",
+		NetworkID: n.ID,
+		Peer:      dfcloud.PeerConfig{AccountID: "123", VPCID: "vpc-1"},
+	})
+	if err != nil {
+		t.Fatalf("CreateConnection() error = %v", err)
+	}
+	s.FailConnection(conn.ID, dfcloud.ConnectionStatusFailed, "peer VPC not found")
+
+	_, err = client.WaitForConnection(ctx, conn.ID, dfcloud.ConnectionStatusInactive)
+	var statusErr *dfcloud.StatusError
+	if !errors.As(err, &statusErr) || statusErr.Detail != "peer VPC not found" {
+		t.Fatalf("WaitForConnection() error = %v, want failed with detail", err)
+	}
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, NewServer())
+
+	config := &dfcloud.DatastoreConfig{
+		Name:     "cache",
+		Location: dfcloud.DatastoreLocation{Provider: dfcloud.CloudProviderAWS, Region: "eu-west-1"},
+		Tier:     dfcloud.DatastoreTier{Memory: 12500000000, PerformanceTier: dfcloud.PerformanceTierStandard},
+	}
+	ds, err := client.CreateDatastore(ctx, config)
+	if err != nil {
+		t.Fatalf("CreateDatastore() error = %v", err)
+	}
+	if _, err := client.WaitForDatastore(ctx, ds.ID, dfcloud.DatastoreStatusActive); err != nil {
+		t.Fatalf("WaitForDatastore() error = %v", err)
+	}
+
+	backup, err := client.CreateBackup(ctx, &dfcloud.BackupConfig{DatastoreID: ds.ID})
+	if err != nil {
+		t.Fatalf("CreateBackup() error = %v", err)
+	}
+	backup, err = client.WaitForBackup(ctx, backup.ID, dfcloud.BackupStatusActive)
+	if err != nil {
+		t.Fatalf("WaitForBackup() error = %v", err)
+	}
+	if backup.Type != dfcloud.BackupTypeManual || backup.SizeBytes == 0 {
+		t.Fatalf("WaitForBackup() = %+v, want manual backup with a size", backup)
+	}
+
+	config.Restore.BackupId = "bkp_missing"
+	if _, err := client.UpdateDatastore(ctx, ds.ID, config); !errors.Is(err, dfcloud.ErrValidation) {
+		t.Fatalf("UpdateDatastore() error = %v, want validation error", err)
+	}
+	config.Restore.BackupId = backup.ID
+	if _, err := client.UpdateDatastore(ctx, ds.ID, config); err != nil {
+		t.Fatalf("UpdateDatastore() error = %v", err)
+	}
+	restored, err := client.WaitForDatastoreRestore(ctx, ds.ID)
+	if err != nil {
+		t.Fatalf("WaitForDatastoreRestore() error = %v", err)
+	}
+	if !restored.Config.Restore.Loaded {
+		t.Fatal("WaitForDatastoreRestore() restore not loaded")
+	}
+}
+
+func TestCreateDatastoreValidatesMemory(t *testing.T) {
+	client := newTestClient(t, NewServer())
+
+	shardMemory := int64(6250000000)
+	tests := map[string]struct {
+		provider dfcloud.CloudProvider
+		tier     dfcloud.DatastoreTier
+		cluster  dfcloud.DatastoreClusterConfig
+		wantErr  bool
+	}{
+		"permitted":          {tier: dfcloud.DatastoreTier{Memory: 25e9, PerformanceTier: "enhanced"}},
+		"not permitted":      {tier: dfcloud.DatastoreTier{Memory: 5e9, PerformanceTier: "enhanced"}, wantErr: true},
+		"unknown tier":       {tier: dfcloud.DatastoreTier{Memory: 25e9, PerformanceTier: "turbo"}, wantErr: true},
+		"cluster multiple":   {tier: dfcloud.DatastoreTier{Memory: 25e9, PerformanceTier: "enhanced"}, cluster: dfcloud.DatastoreClusterConfig{ShardMemory: &shardMemory}},
+		"cluster remainder":  {tier: dfcloud.DatastoreTier{Memory: 30e9, PerformanceTier: "enhanced"}, cluster: dfcloud.DatastoreClusterConfig{ShardMemory: &shardMemory}, wantErr: true},
+		"byoc tier on azure": {provider: dfcloud.CloudProviderAzure, tier: dfcloud.DatastoreTier{Memory: 25e9, PerformanceTier: "byoc"}, wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.CreateDatastore(context.Background(), &dfcloud.DatastoreConfig{
+				Name:     "cache",
+				Location: dfcloud.DatastoreLocation{Provider: cmp.Or(tt.provider, dfcloud.CloudProviderAWS), Region: "eu-west-1"},
+				Tier:     tt.tier,
+				Cluster:  tt.cluster,
+			})
+			if gotErr := errors.Is(err, dfcloud.ErrValidation); gotErr != tt.wantErr {
+				t.Fatalf("CreateDatastore() error = %v, want validation error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := WithClock(func() time.Time { return now })
+	s := NewServer(WithDelay(time.Minute), clock)
+
+	created, err := newTestClient(t, s).CreateNetwork(ctx, &dfcloud.NetworkConfig{
+		Name:      "net",
+		Location:  dfcloud.NetworkLocation{Provider: dfcloud.CloudProviderAWS, Region: "eu-west-1"},
+		CIDRBlock: "192.168.0.0/16",
+	})
+	if err != nil {
+		t.Fatalf("CreateNetwork() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := s.Save(&buf); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded := NewServer(WithDelay(time.Minute), clock)
+	if err := loaded.Load(&buf); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	// The network keeps provisioning after being loaded.
+	if n, ok := loaded.Network(created.ID); !ok || n.Status != dfcloud.NetworkStatusPending {
+		t.Fatalf("Network() = %+v, %v, want pending network", n, ok)
+	}
+	now = now.Add(time.Minute)
+	if n, _ := loaded.Network(created.ID); n.Status != dfcloud.NetworkStatusActive {
+		t.Fatalf("status after delay = %q, want active", n.Status)
+	}
+
+	// New IDs don't reuse the IDs of loaded resources.
+	second, err := newTestClient(t, loaded).CreateNetwork(ctx, &dfcloud.NetworkConfig{
+		Name:      "net-2",
+		Location:  dfcloud.NetworkLocation{Provider: dfcloud.CloudProviderAWS, Region: "eu-west-1"},
+		CIDRBlock: "192.168.0.0/16",
+	})
+	if err != nil {
+		t.Fatalf("CreateNetwork() error = %v", err)
+	}
+	if second.ID == created.ID {
+		t.Fatalf("CreateNetwork() ID = %q, reused loaded ID", second.ID)
+	}
+}
@@ -0,0 +1,108 @@
+package fake
+
+import (
+	"fmt"
+	"net/http"
+
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+)
+
+func (s *Server) listNetworks(w http.ResponseWriter, r *http.Request) {
+	var networks []*dfcloud.Network
+	for _, n := range sorted(s.state.Networks, func(n *network) int { return n.Seq }) {
+		networks = append(networks, &n.Network)
+	}
+
+	items, next, ok := page(w, r, networks)
+	if !ok {
+		return
+	}
+	writeJSON(w, map[string]any{
+		"networks":        items,
+		"next_page_token": next,
+	})
+}
+
+func (s *Server) getNetwork(w http.ResponseWriter, r *http.Request) {
+	n, ok := s.state.Networks[r.PathValue("id")]
+	if !ok {
+		notFound(w, "network", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, &n.Network)
+}
+
+func (s *Server) createNetwork(w http.ResponseWriter, r *http.Request) {
+	var config dfcloud.NetworkConfig
+	if !decode(w, r, &config) {
+		return
+	}
+	if details := validateNetwork(&config); len(details) > 0 {
+		writeError(w, http.StatusBadRequest, "invalid network config", details)
+		return
+	}
+
+	now := s.now()
+	seq := s.state.NextID
+	id := s.state.newID("net")
+	n := &network{
+		Network: dfcloud.Network{
+			ID:            id,
+			Status:        dfcloud.NetworkStatusPending,
+			CreatedAt:     now.Unix(),
+			NetworkConfig: &config,
+		},
+		tracked: tracked{Seq: seq, Since: now},
+	}
+	s.state.Networks[id] = n
+
+	writeJSON(w, &n.Network)
+}
+
+func (s *Server) updateNetwork(w http.ResponseWriter, r *http.Request) {
+	n, ok := s.state.Networks[r.PathValue("id")]
+	if !ok {
+		notFound(w, "network", r.PathValue("id"))
+		return
+	}
+
+	var config dfcloud.NetworkConfig
+	if !decode(w, r, &config) {
+		return
+	}
+	// Only the name of a network can be updated.
+	if config.Name == "" {
+		writeError(w, http.StatusBadRequest, "invalid network config", []dfcloud.ErrorDetail{
+			{Field: "name", Reason: "must not be empty"},
+		})
+		return
+	}
+	n.Name = config.Name
+
+	writeJSON(w, &n.Network)
+}
+
+func (s *Server) deleteNetwork(w http.ResponseWriter, r *http.Request) {
+	n, ok := s.state.Networks[r.PathValue("id")]
+	if !ok {
+		notFound(w, "network", r.PathValue("id"))
+		return
+	}
+	for _, ds := range s.state.Datastores {
+		if ds.Config.NetworkID == n.ID {
+			writeError(w, http.StatusConflict, fmt.Sprintf("network is used by datastore %s", ds.ID), nil)
+			return
+		}
+	}
+	for _, conn := range s.state.Connections {
+		if conn.Config.NetworkID == n.ID {
+			writeError(w, http.StatusConflict, fmt.Sprintf("network is used by connection %s", conn.ID), nil)
+			return
+		}
+	}
+
+	if n.Status != dfcloud.NetworkStatusDeleting {
+		n.setStatus(dfcloud.NetworkStatusDeleting, s.now())
+	}
+	writeJSON(w, &n.Network)
+}
@@ -0,0 +1,104 @@
+package fake
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+)
+
+// state holds the resources of the fake API.
+type state struct {
+	// NextID is the sequence number of the next ID.
+	NextID int `json:"next_id"`
+
+	Datastores  map[string]*datastore  `json:"datastores"`
+	Networks    map[string]*network    `json:"networks"`
+	Connections map[string]*connection `json:"connections"`
+	Backups     map[string]*backup     `json:"backups"`
+
+	// IdempotencyKeys maps the Idempotency-Key of create requests to their
+	// response.
+	IdempotencyKeys map[string]json.RawMessage `json:"idempotency_keys"`
+}
+
+func newState() *state {
+	return &state{
+		NextID:          1,
+		Datastores:      map[string]*datastore{},
+		Networks:        map[string]*network{},
+		Connections:     map[string]*connection{},
+		Backups:         map[string]*backup{},
+		IdempotencyKeys: map[string]json.RawMessage{},
+	}
+}
+
+// newID returns a new unique ID with the given prefix.
+func (s *state) newID(prefix string) string {
+	id := fmt.Sprintf("%s_%d", prefix, s.NextID)
+	s.NextID++
+	return id
+}
+
+// tracked holds when a resource entered its current status, to know when
+// to complete its transition.
+type tracked struct {
+	// Seq orders resources by creation.
+	Seq   int       `json:"seq"`
+	Since time.Time `json:"since"`
+}
+
+type datastore struct {
+	dfcloud.Datastore
+	tracked
+}
+
+func (d *datastore) setStatus(status dfcloud.DatastoreStatus, now time.Time) {
+	d.Status = status
+	d.StatusDetail = ""
+	d.Since = now
+}
+
+type network struct {
+	dfcloud.Network
+	tracked
+}
+
+func (n *network) setStatus(status dfcloud.NetworkStatus, now time.Time) {
+	n.Status = status
+	n.StatusDetail = ""
+	n.Since = now
+}
+
+type connection struct {
+	dfcloud.Connection
+	tracked
+}
+
+func (c *connection) setStatus(status dfcloud.ConnectionStatus, now time.Time) {
+	c.Status = status
+	c.StatusDetail = ""
+	c.Since = now
+}
+
+type backup struct {
+	dfcloud.Backup
+	tracked
+}
+
+func (b *backup) setStatus(status dfcloud.BackupStatus, now time.Time) {
+	b.Status = status
+	b.StatusDetail = ""
+	b.Since = now
+}
+
+// sorted returns the values of m in creation order.
+func sorted[T any](m map[string]T, seq func(T) int) []T {
+	return slices.SortedFunc(maps.Values(m), func(a, b T) int {
+		return cmp.Compare(seq(a), seq(b))
+	})
+}
@@ -0,0 +1,161 @@
+package fake
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+
+	dfcloud "github.com/dragonflydb/terraform-provider-dfcloud/internal/sdk"
+)
+
+var cloudProviders = []dfcloud.CloudProvider{
+	dfcloud.CloudProviderAWS,
+	dfcloud.CloudProviderGCP,
+	dfcloud.CloudProviderAzure,
+}
+
+func (s *Server) validateDatastore(config *dfcloud.DatastoreConfig) []dfcloud.ErrorDetail {
+	var details []dfcloud.ErrorDetail
+	add := func(field, reason string) {
+		details = append(details, dfcloud.ErrorDetail{Field: field, Reason: reason})
+	}
+
+	if config.Name == "" {
+		add("name", "must not be empty")
+	}
+	if !slices.Contains(cloudProviders, config.Location.Provider) {
+		add("location.provider", "must be one of aws, gcp or azure")
+	}
+	if config.Location.Region == "" {
+		add("location.region", "must not be empty")
+	}
+	if config.Tier.Replicas != nil && (*config.Tier.Replicas < 0 || *config.Tier.Replicas > 2) {
+		add("tier.replicas", "must be between 0 and 2")
+	}
+
+	tier := s.catalog.Tier(config.Location.Provider, config.Tier.PerformanceTier)
+	cluster := config.Cluster.Enabled != nil && *config.Cluster.Enabled || config.Cluster.ShardMemory != nil
+	switch {
+	case tier == nil:
+		add("tier.performance_tier", "unsupported performance tier")
+	case config.Tier.Memory == 0:
+		add("tier.max_memory_bytes", "must be greater than 0")
+	case cluster && config.Cluster.ShardMemory != nil:
+		if shard := *config.Cluster.ShardMemory; shard <= 0 || config.Tier.Memory%uint64(shard) != 0 {
+			add("tier.max_memory_bytes", "must be a multiple of cluster.shard_memory")
+		}
+	case !cluster && !slices.Contains(tier.MaxMemoryBytes, config.Tier.Memory):
+		add("tier.max_memory_bytes", "not permitted for tier")
+	}
+
+	var policy dfcloud.BackupPolicy
+	if config.BackupPolicy != nil {
+		policy = *config.BackupPolicy
+	}
+	for i, h := range policy.Hours {
+		if h < 0 || h > 23 {
+			add(fmt.Sprintf("backup_policy.hours.%d", i), "must be between 0 and 23")
+		}
+	}
+	for i, d := range policy.WeekDays {
+		if d < 0 || d > 6 {
+			add(fmt.Sprintf("backup_policy.weekdays.%d", i), "must be between 0 and 6")
+		}
+	}
+	everyHour := policy.EveryHour != nil && *policy.EveryHour
+	everyDay := policy.EveryDay != nil && *policy.EveryDay
+	if everyHour && (everyDay || len(policy.Hours) > 0 || len(policy.WeekDays) > 0) {
+		add("backup_policy.every_hour", "cannot be combined with every_day, hours or weekdays")
+	}
+	if everyDay && len(policy.WeekDays) > 0 {
+		add("backup_policy.every_day", "cannot be combined with weekdays")
+	}
+
+	window := config.MaintenanceWindow
+	if window.Weekday != nil && (*window.Weekday < 0 || *window.Weekday > 6) {
+		add("maintenance_window.weekday", "must be between 0 and 6")
+	}
+	if window.Hour != nil && (*window.Hour < 0 || *window.Hour > 23) {
+		add("maintenance_window.hour", "must be between 0 and 23")
+	}
+	if window.DurationHours != nil && *window.DurationHours < 0 {
+		add("maintenance_window.duration_hours", "must not be negative")
+	}
+
+	if id := config.Restore.BackupId; id != "" {
+		b, ok := s.state.Backups[id]
+		switch {
+		case !ok:
+			add("restore.backup_id", "backup not found")
+		case b.Status != dfcloud.BackupStatusActive:
+			add("restore.backup_id", "backup must be active")
+		}
+	}
+	if config.NetworkID != "" {
+		n, ok := s.state.Networks[config.NetworkID]
+		switch {
+		case !ok:
+			add("network_id", "network not found")
+		case n.Location.Provider != config.Location.Provider || n.Location.Region != config.Location.Region:
+			add("network_id", "network must be in the same location as the datastore")
+		}
+	}
+	return details
+}
+
+func validateNetwork(config *dfcloud.NetworkConfig) []dfcloud.ErrorDetail {
+	var details []dfcloud.ErrorDetail
+	add := func(field, reason string) {
+		details = append(details, dfcloud.ErrorDetail{Field: field, Reason: reason})
+	}
+
+	if config.Name == "" {
+		add("name", "must not be empty")
+	}
+	if !slices.Contains(cloudProviders, config.Location.Provider) {
+		add("location.provider", "must be one of aws, gcp or azure")
+	}
+	if config.Location.Region == "" {
+		add("location.region", "must not be empty")
+	}
+	if prefix, err := netip.ParsePrefix(config.CIDRBlock); err != nil || !prefix.Addr().Is4() {
+		add("cidr_block", "must be an IPv4 CIDR block")
+	}
+	return details
+}
+
+func (s *Server) validateConnection(config *dfcloud.ConnectionConfig) []dfcloud.ErrorDetail {
+	var details []dfcloud.ErrorDetail
+	add := func(field, reason string) {
+		details = append(details, dfcloud.ErrorDetail{Field: field, Reason: reason})
+	}
+
+	if config.Name == "" {
+		add("name", "must not be empty")
+	}
+	n, ok := s.state.Networks[config.NetworkID]
+	switch {
+	case !ok:
+		add("network_id", "network not found")
+	case n.Status != dfcloud.NetworkStatusActive:
+		add("network_id", "network must be active")
+	}
+	if config.Peer.AccountID == "" {
+		add("peer.account_id", "must not be empty")
+	}
+	if config.Peer.VPCID == "" {
+		add("peer.vpc_id", "must not be empty")
+	}
+	return details
+}
+
+func (s *Server) validateBackup(config *dfcloud.BackupConfig) []dfcloud.ErrorDetail {
+	ds, ok := s.state.Datastores[config.DatastoreID]
+	switch {
+	case !ok:
+		return []dfcloud.ErrorDetail{{Field: "datastore_id", Reason: "datastore not found"}}
+	case ds.Status != dfcloud.DatastoreStatusActive:
+		return []dfcloud.ErrorDetail{{Field: "datastore_id", Reason: "datastore must be active"}}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package sdk
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+)
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey returns a context that makes create requests sent with
+// it use the given Idempotency-Key header.
+//
+// If the API has already processed a create with the same key, it returns
+// the original resource instead of creating a duplicate. This makes it safe
+// to retry a create whose response was lost.
+//
+// Without a key in the context, the client generates a random key for each
+// create call and reuses it across that call's retries. Retries within the
+// call are deduplicated, but a create repeated by a new call, such as a later
+// terraform apply after the response was lost, is not. A key derived from
+// the resource config isn't used instead, since it would make recreating an
+// identical resource replay the response for the one it replaced.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key set with
+// [WithIdempotencyKey], if any.
+func IdempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key, ok && key != ""
+}
+
+// NewIdempotencyKey returns a new random idempotency key.
+func NewIdempotencyKey() string {
+	var b [16]byte
+	// Read never returns an error.
+	_, _ = rand.Read(b[:])
+
+	// Format as a version 4 UUID.
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
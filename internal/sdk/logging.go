@@ -0,0 +1,123 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// redacted replaces secret values in request logs.
+const redacted = "REDACTED"
+
+// RequestLog describes a single HTTP request sent by the client. Secrets,
+// such as the Authorization header, datastore passwords and ACL rules, are
+// redacted.
+type RequestLog struct {
+	Method string
+	URL    string
+	// Attempt is the attempt number of the request, starting at 1, which is
+	// greater than 1 when the request is retried.
+	Attempt        int
+	RequestHeaders http.Header
+	// RequestBody is only set if [WithRequestBodyLogging] is enabled.
+	RequestBody string
+	// StatusCode is the response status code, or 0 if the request failed
+	// before receiving a response.
+	StatusCode int
+	// ResponseBody is only set if [WithRequestBodyLogging] is enabled.
+	ResponseBody string
+	Duration     time.Duration
+	// Err is the error returned sending the request, if any.
+	Err error
+}
+
+// RequestLogger is called after each HTTP request the client sends.
+type RequestLogger func(ctx context.Context, log RequestLog)
+
+type requestLoggerOption RequestLogger
+
+func (o requestLoggerOption) apply(opts *clientOptions) {
+	opts.requestLogger = RequestLogger(o)
+}
+
+// WithRequestLogger configures the client to call logger after each HTTP
+// request, including retries. By default requests are not logged.
+//
+// Request and response bodies are only logged if [WithRequestBodyLogging] is
+// also set.
+func WithRequestLogger(logger RequestLogger) ClientOption {
+	return requestLoggerOption(logger)
+}
+
+type requestBodyLoggingOption bool
+
+func (o requestBodyLoggingOption) apply(opts *clientOptions) {
+	opts.logRequestBodies = bool(o)
+}
+
+// WithRequestBodyLogging configures the client to include request and
+// response bodies in request logs. Logging the response body means reading
+// it fully before it is decoded, so this should only be enabled when the
+// bodies are actually written to the log.
+func WithRequestBodyLogging(enabled bool) ClientOption {
+	return requestBodyLoggingOption(enabled)
+}
+
+// redactHeaders returns a copy of h with credentials redacted.
+func redactHeaders(h http.Header) http.Header {
+	h = h.Clone()
+	if h.Get("Authorization") != "" {
+		h.Set("Authorization", redacted)
+	}
+	return h
+}
+
+// redactBody returns the body with secrets redacted. Bodies that aren't JSON
+// are returned unchanged.
+func redactBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+
+	b, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return string(body)
+	}
+	return string(b)
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, field := range v {
+			switch k {
+			case "password":
+				if s, ok := field.(string); ok && s != "" {
+					v[k] = redacted
+				}
+			case "acl_rules":
+				// ACL rules embed user passwords, such as
+				// "USER default ON >password +@ALL".
+				if rules, ok := field.([]any); ok {
+					for i := range rules {
+						rules[i] = redacted
+					}
+				}
+			default:
+				v[k] = redactValue(field)
+			}
+		}
+	case []any:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+	}
+	return v
+}
@@ -1,5 +1,10 @@
 package sdk
 
+import (
+	"net/url"
+	"strings"
+)
+
 // NetworkStatus represents the current status of the network.
 type NetworkStatus string
 
@@ -45,6 +50,10 @@ type Network struct {
 
 	Status NetworkStatus `json:"status"`
 
+	// StatusDetail provides more information on the status of the network,
+	// such as why it failed to provision.
+	StatusDetail string `json:"status_detail,omitempty"`
+
 	CreatedAt int64 `json:"created_at"`
 
 	// VPC contains details on the networks provisioned VPC. This is required
@@ -53,3 +62,49 @@ type Network struct {
 
 	*NetworkConfig
 }
+
+// ListNetworksOptions filters the networks returned by [Client.ListNetworks].
+// Empty fields don't filter.
+type ListNetworksOptions struct {
+	// NamePrefix only includes networks whose name starts with the prefix.
+	NamePrefix string
+	// Provider only includes networks in the given cloud provider.
+	Provider CloudProvider
+	// Region only includes networks in the given region.
+	Region string
+	// Status only includes networks with the given status.
+	Status NetworkStatus
+	// BYOCAccountID only includes networks provisioned into the given BYOC
+	// account.
+	BYOCAccountID string
+}
+
+func (o *ListNetworksOptions) values() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	setQuery(q, "name_prefix", o.NamePrefix)
+	setQuery(q, "provider", string(o.Provider))
+	setQuery(q, "region", o.Region)
+	setQuery(q, "status", string(o.Status))
+	setQuery(q, "byoc_account_id", o.BYOCAccountID)
+	return q
+}
+
+// matches reports whether the network passes the filters, in case the API
+// ignored any of them.
+func (o *ListNetworksOptions) matches(n *Network) bool {
+	if o == nil {
+		return true
+	}
+	var config NetworkConfig
+	if n.NetworkConfig != nil {
+		config = *n.NetworkConfig
+	}
+	return strings.HasPrefix(config.Name, o.NamePrefix) &&
+		matchFilter(o.Provider, config.Location.Provider) &&
+		matchFilter(o.Region, config.Location.Region) &&
+		matchFilter(o.Status, n.Status) &&
+		matchFilter(o.BYOCAccountID, config.BYOC.AccountID)
+}
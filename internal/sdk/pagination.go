@@ -0,0 +1,174 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// DefaultPageSize is the number of items requested per page when listing
+// resources.
+const DefaultPageSize = 100
+
+type pageSizeOption int
+
+func (o pageSizeOption) apply(opts *clientOptions) {
+	opts.pageSize = int(o)
+}
+
+// WithPageSize configures the number of items the client requests per page
+// when listing resources. Defaults to [DefaultPageSize].
+func WithPageSize(size int) ClientOption {
+	return pageSizeOption(size)
+}
+
+// listPage fetches a single page of items from a list endpoint.
+//
+// Paginated endpoints respond with an object containing the items under key
+// and a next_page_token, which is empty on the last page. Endpoints that
+// don't paginate respond with a plain JSON array, which is treated as a
+// single page.
+func listPage[T any](
+	ctx context.Context,
+	c *Client,
+	path string,
+	key string,
+	query url.Values,
+	pageToken string,
+) ([]T, string, error) {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	if c.pageSize > 0 {
+		q.Set("page_size", strconv.Itoa(c.pageSize))
+	}
+	if pageToken != "" {
+		q.Set("page_token", pageToken)
+	}
+
+	r, err := c.request(ctx, http.MethodGet, path, q, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	defer r.Close()
+
+	var raw json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, "", fmt.Errorf("decode response: %w", err)
+	}
+
+	var items []T
+	if trimmed := bytes.TrimSpace(raw); len(trimmed) == 0 || trimmed[0] != '{' {
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, "", fmt.Errorf("decode response: %w", err)
+		}
+		return items, "", nil
+	}
+
+	var page map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &page); err != nil {
+		return nil, "", fmt.Errorf("decode response: %w", err)
+	}
+	if b, ok := page[key]; ok {
+		if err := json.Unmarshal(b, &items); err != nil {
+			return nil, "", fmt.Errorf("decode response: %w", err)
+		}
+	}
+	var next string
+	if b, ok := page["next_page_token"]; ok {
+		if err := json.Unmarshal(b, &next); err != nil {
+			return nil, "", fmt.Errorf("decode response: %w", err)
+		}
+	}
+
+	return items, next, nil
+}
+
+// paginate returns an iterator over every item of a list endpoint, fetching
+// pages lazily as the iteration progresses.
+//
+// Iteration stops after yielding the first error.
+func paginate[T any](
+	ctx context.Context,
+	c *Client,
+	path string,
+	key string,
+	query url.Values,
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		token := ""
+		// seen holds the page tokens already fetched, so a server that
+		// returns a cycle of tokens can't make iteration loop forever.
+		seen := map[string]bool{}
+		for {
+			items, next, err := listPage[T](ctx, c, path, key, query, token)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if next == "" {
+				return
+			}
+			seen[token] = true
+			if seen[next] {
+				yield(zero, fmt.Errorf("list %s: repeated page token %q", path, next))
+				return
+			}
+			token = next
+		}
+	}
+}
+
+// filter returns an iterator over the items of seq that keep returns true
+// for. Errors are always passed through.
+func filter[T any](seq iter.Seq2[T, error], keep func(T) bool) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for item, err := range seq {
+			if err == nil && !keep(item) {
+				continue
+			}
+			if !yield(item, err) {
+				return
+			}
+		}
+	}
+}
+
+// setQuery sets the query parameter if the value is not empty.
+func setQuery(q url.Values, key string, value string) {
+	if value != "" {
+		q.Set(key, value)
+	}
+}
+
+// matchFilter reports whether value matches the filter, where an empty filter
+// matches everything.
+func matchFilter[T ~string](filter T, value T) bool {
+	return filter == "" || filter == value
+}
+
+// All collects every item from seq, returning the first error encountered.
+func All[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,135 @@
+package sdk
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how the client retries failed requests.
+//
+// Requests are retried when the API responds with 429 Too Many Requests, or
+// when an idempotent request fails with a network error or a 5xx status.
+// GET, PUT and DELETE requests are idempotent, as are create requests since
+// they carry an Idempotency-Key header.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the initial attempt.
+	// Zero disables retries.
+	MaxRetries int
+	// MinBackoff is the delay before the first retry. The delay doubles on
+	// each subsequent retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between retries, including delays requested
+	// by the API with a Retry-After header.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy used when none is configured.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 4,
+	MinBackoff: 500 * time.Millisecond,
+	MaxBackoff: 30 * time.Second,
+}
+
+type retryPolicyOption RetryPolicy
+
+func (o retryPolicyOption) apply(opts *clientOptions) {
+	opts.retryPolicy = RetryPolicy(o)
+}
+
+// WithRetryPolicy configures how the client retries throttled and failed
+// requests. Defaults to [DefaultRetryPolicy].
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return retryPolicyOption(policy)
+}
+
+// backoff returns the delay before the given retry attempt (starting at 1)
+// using exponential backoff with equal jitter: a random delay between half
+// and all of the exponential delay.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MinBackoff
+	for i := 1; i < attempt && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	// Jitter keeps concurrent applies from retrying in lockstep.
+	return d/2 + rand.N(d/2+1)
+}
+
+// delay returns how long to wait before the given retry attempt, honouring
+// the Retry-After header of resp when present.
+func (p RetryPolicy) delay(attempt int, resp *http.Response, now time.Time) time.Duration {
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After"), now); ok {
+			if p.MaxBackoff > 0 && d > p.MaxBackoff {
+				d = p.MaxBackoff
+			}
+			return d
+		}
+	}
+	return p.backoff(attempt)
+}
+
+// parseRetryAfter parses a Retry-After header, which is either a number of
+// seconds or an HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := t.Sub(now)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// isIdempotent returns true if the method can safely be repeated.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// shouldRetry returns true if a request that failed with err or returned
+// status should be retried.
+//
+// 429 responses are retried whatever the method, even without a Retry-After
+// header. This is only safe for POST requests because they always carry an
+// Idempotency-Key, so the API replays the original response rather than
+// creating the resource again if the throttled request was in fact handled.
+func shouldRetry(idempotent bool, status int, err error) bool {
+	if status == http.StatusTooManyRequests {
+		return true
+	}
+	if !idempotent {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	switch status {
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
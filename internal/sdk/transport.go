@@ -0,0 +1,147 @@
+package sdk
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+type httpClientOption struct {
+	client *http.Client
+}
+
+func (o httpClientOption) apply(opts *clientOptions) {
+	opts.httpClient = o.client
+}
+
+// WithHTTPClient configures the client to send requests with the given HTTP
+// client.
+//
+// The HTTP client is used as is, so [WithTimeout], [WithTransport],
+// [WithCACertFile], [WithProxyURL] and [WithInsecureSkipVerify] are ignored.
+func WithHTTPClient(client *http.Client) ClientOption {
+	return httpClientOption{client: client}
+}
+
+type transportOption struct {
+	transport http.RoundTripper
+}
+
+func (o transportOption) apply(opts *clientOptions) {
+	opts.transport = o.transport
+}
+
+// WithTransport configures the client to send requests with the given
+// transport.
+//
+// The transport is used as is, so [WithCACertFile], [WithProxyURL] and
+// [WithInsecureSkipVerify] are ignored.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return transportOption{transport: transport}
+}
+
+type caCertFileOption string
+
+func (o caCertFileOption) apply(opts *clientOptions) {
+	opts.caCertFile = string(o)
+}
+
+// WithCACertFile configures the client to trust the PEM encoded CA
+// certificates in the given file, in addition to the system certificate
+// pool.
+func WithCACertFile(path string) ClientOption {
+	return caCertFileOption(path)
+}
+
+type proxyURLOption string
+
+func (o proxyURLOption) apply(opts *clientOptions) {
+	opts.proxyURL = string(o)
+}
+
+// WithProxyURL configures the client to send requests through the given
+// proxy, such as "http://proxy.internal:3128".
+//
+// By default the proxy is read from the HTTPS_PROXY and NO_PROXY
+// environment variables.
+func WithProxyURL(url string) ClientOption {
+	return proxyURLOption(url)
+}
+
+type insecureSkipVerifyOption bool
+
+func (o insecureSkipVerifyOption) apply(opts *clientOptions) {
+	opts.insecureSkipVerify = bool(o)
+}
+
+// WithInsecureSkipVerify configures the client to skip verifying the API TLS
+// certificate. This should only be used for testing.
+func WithInsecureSkipVerify(skip bool) ClientOption {
+	return insecureSkipVerifyOption(skip)
+}
+
+// newHTTPClient builds the HTTP client described by the options.
+func newHTTPClient(opts clientOptions) (*http.Client, error) {
+	if opts.httpClient != nil {
+		return opts.httpClient, nil
+	}
+
+	transport := opts.transport
+	if transport == nil {
+		t, err := newTransport(opts)
+		if err != nil {
+			return nil, err
+		}
+		transport = t
+	}
+
+	return &http.Client{
+		Timeout:   opts.timeout,
+		Transport: transport,
+	}, nil
+}
+
+func newTransport(opts clientOptions) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if opts.proxyURL != "" {
+		proxyURL, err := url.Parse(opts.proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy url: %w", err)
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy url: %q: missing scheme or host", opts.proxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if opts.caCertFile != "" || opts.insecureSkipVerify {
+		tlsConfig := &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: opts.insecureSkipVerify,
+		}
+
+		if opts.caCertFile != "" {
+			pem, err := os.ReadFile(opts.caCertFile)
+			if err != nil {
+				return nil, fmt.Errorf("read ca cert file: %w", err)
+			}
+
+			pool, err := x509.SystemCertPool()
+			if err != nil {
+				pool = x509.NewCertPool()
+			}
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("read ca cert file: no certificates found in %s", opts.caCertFile)
+			}
+			tlsConfig.RootCAs = pool
+		}
+
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return transport, nil
+}
@@ -0,0 +1,298 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultMinPollInterval is the default delay before the first status
+	// poll. The delay doubles after each poll.
+	DefaultMinPollInterval = time.Second
+	// DefaultMaxPollInterval is the default cap on the delay between status
+	// polls.
+	DefaultMaxPollInterval = 15 * time.Second
+
+	// progressInterval is how often progress is logged while the status is
+	// unchanged.
+	progressInterval = 30 * time.Second
+)
+
+type pollIntervalOption struct {
+	min, max time.Duration
+}
+
+func (o pollIntervalOption) apply(opts *clientOptions) {
+	opts.minPollInterval = o.min
+	opts.maxPollInterval = o.max
+}
+
+// WithPollInterval configures how often waiters poll the status of a
+// resource. The first poll is after minInterval, doubling after each poll up
+// to maxInterval. Defaults to [DefaultMinPollInterval] and
+// [DefaultMaxPollInterval].
+func WithPollInterval(minInterval, maxInterval time.Duration) ClientOption {
+	return pollIntervalOption{min: minInterval, max: maxInterval}
+}
+
+// StatusError is returned when waiting for a resource that reaches a
+// terminal failure status.
+type StatusError struct {
+	// Kind is the kind of resource, such as "network".
+	Kind   string
+	ID     string
+	Status string
+	// Detail is the status detail reported by the API, if any.
+	Detail string
+}
+
+func (e *StatusError) Error() string {
+	msg := fmt.Sprintf("%s %s is %s", e.Kind, e.ID, e.Status)
+	if e.Detail != "" {
+		msg += ": " + e.Detail
+	}
+	return msg
+}
+
+// WaitProgress describes a resource that hasn't reached its target status
+// yet.
+type WaitProgress struct {
+	// Kind is the kind of resource, such as "network".
+	Kind   string
+	ID     string
+	Status string
+	Detail string
+	Target []string
+	// Elapsed is how long the waiter has been waiting.
+	Elapsed time.Duration
+}
+
+// WaitLogger is called periodically while waiting for a resource, and
+// whenever its status changes.
+type WaitLogger func(ctx context.Context, progress WaitProgress)
+
+type waitLoggerOption WaitLogger
+
+func (o waitLoggerOption) apply(opts *clientOptions) {
+	opts.waitLogger = WaitLogger(o)
+}
+
+// WithWaitLogger configures the client to report the progress of waiters to
+// logger. By default progress is not reported.
+func WithWaitLogger(logger WaitLogger) ClientOption {
+	return waitLoggerOption(logger)
+}
+
+// Waiter polls a resource until it reaches one of the Target statuses.
+type Waiter[T any, S ~string] struct {
+	// Kind is the kind of resource, such as "network", used in logs and
+	// errors.
+	Kind string
+	// Get fetches the resource with the given ID.
+	Get func(ctx context.Context, id string) (T, error)
+	// Status returns the status of the resource and its status detail.
+	Status func(T) (S, string)
+	// Target are the statuses to wait for.
+	Target []S
+	// Failed are terminal statuses. The waiter returns a [*StatusError] as
+	// soon as the resource reaches one of them.
+	Failed []S
+	// Ready optionally reports whether a resource in a target status is
+	// done, such as a datastore that has finished restoring a backup.
+	Ready func(T) bool
+	// Deleted optionally returns the resource to report when it isn't
+	// found, when waiting for it to be deleted. If nil, resources that
+	// aren't found are polled again, since a new resource may not be
+	// visible yet.
+	Deleted func(id string) T
+	// Existing reports that the resource is known to exist, so the waiter
+	// returns [ErrNotFound] if it isn't found rather than polling again.
+	Existing bool
+}
+
+// Wait polls the resource with the given ID until it is in one of the
+// target statuses, using exponential backoff between polls. It returns
+// early with a [*StatusError] if the resource fails, or with the context
+// error if ctx is done first.
+func Wait[T any, S ~string](ctx context.Context, c *Client, w Waiter[T, S], id string) (T, error) {
+	var zero T
+	if id == "" {
+		return zero, fmt.Errorf("missing %s id", w.Kind)
+	}
+
+	target := make([]string, 0, len(w.Target))
+	for _, s := range w.Target {
+		target = append(target, string(s))
+	}
+
+	start := c.clock.Now()
+	interval := c.minPollInterval
+	var (
+		lastStatus S
+		lastLog    time.Time
+	)
+	// timedOut wraps the context error with the status the resource was
+	// stuck in.
+	timedOut := func() error {
+		if lastStatus == "" {
+			return fmt.Errorf("waiting for %s %s to be %s: %w", w.Kind, id, strings.Join(target, " or "), ctx.Err())
+		}
+		return fmt.Errorf("waiting for %s %s to be %s, last status %s: %w", w.Kind, id, strings.Join(target, " or "), lastStatus, ctx.Err())
+	}
+	for {
+		resource, err := w.Get(ctx, id)
+		switch {
+		case errors.Is(err, ErrNotFound) && w.Deleted != nil:
+			return w.Deleted(id), nil
+		case errors.Is(err, ErrNotFound) && w.Existing:
+			return zero, err
+		case errors.Is(err, ErrNotFound):
+		case err != nil && ctx.Err() != nil:
+			return zero, timedOut()
+		case err != nil:
+			return zero, err
+		default:
+			status, detail := w.Status(resource)
+			if slices.Contains(w.Target, status) && (w.Ready == nil || w.Ready(resource)) {
+				return resource, nil
+			}
+			if slices.Contains(w.Failed, status) {
+				return resource, &StatusError{
+					Kind:   w.Kind,
+					ID:     id,
+					Status: string(status),
+					Detail: detail,
+				}
+			}
+
+			now := c.clock.Now()
+			if c.waitLogger != nil && (status != lastStatus || now.Sub(lastLog) >= progressInterval) {
+				c.waitLogger(ctx, WaitProgress{
+					Kind:    w.Kind,
+					ID:      id,
+					Status:  string(status),
+					Detail:  detail,
+					Target:  target,
+					Elapsed: now.Sub(start),
+				})
+				lastLog = now
+			}
+			lastStatus = status
+		}
+
+		select {
+		case <-ctx.Done():
+			return zero, timedOut()
+		case <-c.clock.After(interval):
+		}
+		interval = min(interval*2, c.maxPollInterval)
+	}
+}
+
+// WaitForDatastore waits for the datastore to reach the target status. When
+// waiting for [DatastoreStatusDeleted], a datastore that isn't found is
+// treated as deleted.
+func (c *Client) WaitForDatastore(ctx context.Context, id string, status DatastoreStatus) (*Datastore, error) {
+	return Wait(ctx, c, Waiter[*Datastore, DatastoreStatus]{
+		Kind:   "datastore",
+		Get:    c.GetDatastore,
+		Status: func(ds *Datastore) (DatastoreStatus, string) { return ds.Status, ds.StatusDetail },
+		Target: []DatastoreStatus{status},
+		Deleted: deletedIf(status == DatastoreStatusDeleted, func(id string) *Datastore {
+			return &Datastore{ID: id, Status: DatastoreStatusDeleted}
+		}),
+	}, id)
+}
+
+// WaitForDatastoreRestore waits for the datastore to finish restoring the
+// requested backup and become active.
+func (c *Client) WaitForDatastoreRestore(ctx context.Context, id string) (*Datastore, error) {
+	return Wait(ctx, c, Waiter[*Datastore, DatastoreStatus]{
+		Kind:   "datastore",
+		Get:    c.GetDatastore,
+		Status: func(ds *Datastore) (DatastoreStatus, string) { return ds.Status, ds.StatusDetail },
+		Target: []DatastoreStatus{DatastoreStatusActive},
+		Ready: func(ds *Datastore) bool {
+			return ds.Config.Restore.BackupId == "" || ds.Config.Restore.Loaded
+		},
+	}, id)
+}
+
+// WaitForDatastoreProvisioned waits for a pending datastore that has been
+// read from the API to finish provisioning, including restoring the backup
+// it was created from. Since the datastore is known to exist, it returns
+// [ErrNotFound] if the datastore is deleted while waiting.
+func (c *Client) WaitForDatastoreProvisioned(ctx context.Context, id string) (*Datastore, error) {
+	return Wait(ctx, c, Waiter[*Datastore, DatastoreStatus]{
+		Kind:   "datastore",
+		Get:    c.GetDatastore,
+		Status: func(ds *Datastore) (DatastoreStatus, string) { return ds.Status, ds.StatusDetail },
+		Target: []DatastoreStatus{DatastoreStatusActive},
+		Ready: func(ds *Datastore) bool {
+			return ds.Config.Restore.BackupId == "" || ds.Config.Restore.Loaded
+		},
+		Existing: true,
+	}, id)
+}
+
+// WaitForNetwork waits for the network to reach the target status. It fails
+// if the network fails to provision.
+func (c *Client) WaitForNetwork(ctx context.Context, id string, status NetworkStatus) (*Network, error) {
+	return Wait(ctx, c, Waiter[*Network, NetworkStatus]{
+		Kind:   "network",
+		Get:    c.GetNetwork,
+		Status: func(n *Network) (NetworkStatus, string) { return n.Status, n.StatusDetail },
+		Target: []NetworkStatus{status},
+		Failed: failedUnlessTarget(status, NetworkStatusFailed),
+		Deleted: deletedIf(status == NetworkStatusDeleted, func(id string) *Network {
+			return &Network{ID: id, Status: NetworkStatusDeleted}
+		}),
+	}, id)
+}
+
+// WaitForConnection waits for the connection to reach the target status. It
+// fails if the connection fails or becomes irrecoverable.
+func (c *Client) WaitForConnection(ctx context.Context, id string, status ConnectionStatus) (*Connection, error) {
+	return Wait(ctx, c, Waiter[*Connection, ConnectionStatus]{
+		Kind:   "connection",
+		Get:    c.GetConnection,
+		Status: func(conn *Connection) (ConnectionStatus, string) { return conn.Status, conn.StatusDetail },
+		Target: []ConnectionStatus{status},
+		Failed: failedUnlessTarget(status, ConnectionStatusFailed, ConnectionStatusIrrecoverable),
+		Deleted: deletedIf(status == ConnectionStatusDeleted, func(id string) *Connection {
+			return &Connection{ID: id, Status: ConnectionStatusDeleted}
+		}),
+	}, id)
+}
+
+// WaitForBackup waits for the backup to reach the target status. It fails if
+// the backup can't be taken.
+func (c *Client) WaitForBackup(ctx context.Context, id string, status BackupStatus) (*Backup, error) {
+	return Wait(ctx, c, Waiter[*Backup, BackupStatus]{
+		Kind:   "backup",
+		Get:    c.GetBackup,
+		Status: func(b *Backup) (BackupStatus, string) { return b.Status, b.StatusDetail },
+		Target: []BackupStatus{status},
+		Failed: failedUnlessTarget(status, BackupStatusFailed),
+		Deleted: deletedIf(status == BackupStatusDeleted, func(id string) *Backup {
+			return &Backup{ID: id, Status: BackupStatusDeleted}
+		}),
+	}, id)
+}
+
+// failedUnlessTarget returns the failed statuses, excluding the target.
+func failedUnlessTarget[S ~string](target S, failed ...S) []S {
+	return slices.DeleteFunc(failed, func(s S) bool { return s == target })
+}
+
+// deletedIf returns deleted if waiting for deletion, or nil otherwise.
+func deletedIf[T any](waitingForDeletion bool, deleted func(id string) T) func(id string) T {
+	if !waitingForDeletion {
+		return nil
+	}
+	return deleted
+}